@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/JCPrice0024/lic-col/src/lic"
+)
+
+// runBaseline runs the baseline command and returns the exit code.
+func runBaseline(args []string) int {
+	if len(args) == 0 || args[0] != "update" {
+		log.Println("usage: licenseCol baseline update -baseline=baseline.json -types=repo_Licenses/licensetypes.json -reviewer=name")
+		return 2
+	}
+	updateFlags := flag.NewFlagSet("baseline update", flag.ExitOnError)
+	baselineFile := updateFlags.String("baseline", "", "The baseline flag is the baseline file to refresh, it is created if it doesn't exist")
+	types := updateFlags.String("types", "", "The types flag is the licensetypes.json file of the scan to accept")
+	reviewer := updateFlags.String("reviewer", "", "The reviewer flag is the name recorded on new entries")
+	expires := updateFlags.String("expires", "", "The expires flag is an optional YYYY-MM-DD date after which new entries stop being accepted")
+	updateFlags.Parse(args[1:])
+
+	if *baselineFile == "" || *types == "" || *reviewer == "" {
+		updateFlags.PrintDefaults()
+		return 2
+	}
+	added, removed, err := lic.UpdateBaseline(*baselineFile, *types, *reviewer, *expires)
+	if err != nil {
+		log.Println(err)
+		return 2
+	}
+	log.Printf("Baseline updated: %d entries added, %d entries removed", added, removed)
+	return 0
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/JCPrice0024/lic-col/src/lic"
+)
+
+// runDiff runs the diff command, it compares two scans and returns the exit code. It exits with 1
+// if a change crosses a policy boundary and 2 if the diff failed.
+func runDiff(args []string) int {
+	diffFlags := flag.NewFlagSet("diff", flag.ExitOnError)
+	repo := diffFlags.String("repo", "", "The repo flag is the repo to scan at both refs, it is only used with the from and to flags")
+	from := diffFlags.String("from", "", "The from flag is the old branch, tag or commit hash of the repo")
+	to := diffFlags.String("to", "", "The to flag is the new branch, tag or commit hash of the repo")
+	goproxy := diffFlags.String("goproxy", "", "The goproxy flag is a GOPROXY list to fetch module sources from instead of running go mod download")
+	asJSON := diffFlags.Bool("json", false, "The json flag prints the report as json")
+	diffFlags.Usage = func() {
+		log.Println("usage: licenseCol diff old/licensetypes.json new/licensetypes.json")
+		log.Println("       licenseCol diff -repo=https://github.com/owner/reponame.git -from=v1.0.0 -to=main")
+		diffFlags.PrintDefaults()
+	}
+	diffFlags.Parse(args)
+
+	var drift lic.Drift
+	var err error
+	switch {
+	case *repo != "" && *from != "" && *to != "":
+		drift, err = lic.DiffRefs(lic.Launch{Repo: *repo, GoProxy: *goproxy}, *from, *to)
+	case diffFlags.NArg() == 2:
+		drift, err = lic.DiffFiles(diffFlags.Arg(0), diffFlags.Arg(1))
+	default:
+		diffFlags.Usage()
+		return 2
+	}
+	if err != nil {
+		log.Println(err)
+		return 2
+	}
+	if *asJSON {
+		err = drift.WriteJSON(os.Stdout)
+		if err != nil {
+			log.Println(err)
+			return 2
+		}
+	} else {
+		drift.Write(os.Stdout)
+	}
+	if drift.CrossesPolicy() {
+		log.Println("License changes cross a policy boundary")
+		return 1
+	}
+	return 0
+}
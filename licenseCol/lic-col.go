@@ -1,46 +1,105 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
+	"os"
+	"os/signal"
 
 	"github.com/JCPrice0024/lic-col/src/lic"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "diff":
+			os.Exit(runDiff(os.Args[2:]))
+		case "baseline":
+			os.Exit(runBaseline(os.Args[2:]))
+		}
+	}
 
 	gitValidation := flag.Bool("git-check", false, "git-check allows for githubapi validation, it requires you to enter your github personal access token and username via Standard Input.")
+	reposFile := flag.String("repos-file", "", "The repos-file flag is a file listing repos to scan, one per line in the form: repo [version]. It replaces the repo flag and writes a combined portfolio report into the dst")
 	repo := flag.String("repo", "", "The repo flag is the github repo you'd like to scan, it can be in the form https://github.com/owner/reponame.git or git@github.com:owner/reponame.git")
 	dst := flag.String("dst", "", "The dst flag is the path where you want all of the scanned licenses to go")
 	cleanupMod := flag.Bool("clean-mod", false, "The clean-mod flag will remove all downloaded folders from the go mod download")
-	cleanupClone := flag.Bool("clean-clone", false, "The clean-clone flag will remove all downloaded folders from the git clone")
+	cleanupClone := flag.Bool("clean-clone", false, "The clean-clone flag will remove the git clone made in GOPATH/src by -gopath-clone, existing clones are never removed")
+	keepClone := flag.Bool("keep-clone", false, "The keep-clone flag keeps the temporary clone instead of removing it when the scan is done")
+	gopathClone := flag.Bool("gopath-clone", false, "The gopath-clone flag performs a full git clone into GOPATH/src and reuses existing clones instead of using a temporary shallow clone")
 	html := flag.Bool("tohtml", false, "tohtml copies all licenses into html, this makes the results of the scan much cleaner")
-	version := flag.String("version", "", "The version flag is the commit hash of the repo you want to scan, if empty it scans the current version")
+	version := flag.String("version", "", "Deprecated: use ref. The version flag is the commit hash of the repo you want to scan, if empty it scans the current version")
+	ref := flag.String("ref", "", "The ref flag is the branch, tag or commit hash of the repo you want to scan, if empty it scans the current version. Existing clones are scanned through a temporary git worktree")
+	baseline := flag.String("baseline", "", "The baseline flag is a file of accepted findings, the scan only reports and fails on findings it doesn't cover")
+	projectLicense := flag.String("project-license", "", "The project-license flag is the license the scanned repo is released under (name or SPDX id, example: Apache-2.0), it overrides the license detected in the repo for the compatibility check")
+	noSPDXList := flag.Bool("no-spdx-list", false, "The no-spdx-list flag only matches the licenses of definedlicenses.json instead of also matching the embedded SPDX license list")
+	goproxy := flag.String("goproxy", "", "The goproxy flag is a GOPROXY list (https:// or file:// urls) to fetch module sources from instead of running go mod download")
+	cloneTimeout := flag.Duration("clone-timeout", 0, "The clone-timeout flag limits how long cloning the repo and checking out the ref may take (example: 5m), 0 for no limit")
+	downloadTimeout := flag.Duration("download-timeout", 0, "The download-timeout flag limits how long each go mod download and each module fetched from the goproxy may take, 0 for no limit")
+	archive := flag.String("archive", "", "The archive flag is a .zip, .tar.gz or .tgz file the report is written into instead of the dst, the archive is reproducible: sorted entries, fixed timestamps and a SHA256SUMS manifest of every entry")
+	timestamp := flag.Bool("timestamp", false, "The timestamp flag records the time of the scan in metadata.json, without it the report holds no wall-clock data so reports of the same commit are identical")
+	scanTimeout := flag.Duration("scan-timeout", 0, "The scan-timeout flag limits how long scanning the dependencies may take, when it runs out the report of the modules scanned so far is written. 0 for no limit")
 
 	flag.Parse()
 
-	if *repo == "" {
+	if *repo == "" && *reposFile == "" {
 		log.Println("No repo provided exiting")
 		flag.PrintDefaults()
 		return
 	}
-	if *dst == "" {
-		log.Println("No dst provided exiting")
+	if *dst == "" && *archive == "" {
+		log.Println("No dst or archive provided exiting")
 		flag.PrintDefaults()
 		return
 	}
 	launcher := lic.Launch{
-		Repo:         *repo,
-		Dst:          *dst,
-		Version:      *version,
-		CleanupMod:   *cleanupMod,
-		CleanupClone: *cleanupClone,
-		ToHTML:       *html,
-		GitCheck:     *gitValidation,
+		Repo:            *repo,
+		Dst:             *dst,
+		Version:         *version,
+		Ref:             *ref,
+		CleanupMod:      *cleanupMod,
+		CleanupClone:    *cleanupClone,
+		KeepClone:       *keepClone,
+		GopathClone:     *gopathClone,
+		ToHTML:          *html,
+		GitCheck:        *gitValidation,
+		GoProxy:         *goproxy,
+		Baseline:        *baseline,
+		ProjectLicense:  *projectLicense,
+		NoSPDXList:      *noSPDXList,
+		CloneTimeout:    *cloneTimeout,
+		DownloadTimeout: *downloadTimeout,
+		ScanTimeout:     *scanTimeout,
+		Archive:         *archive,
+		Timestamp:       *timestamp,
+	}
+
+	// The first Ctrl-C stops the scan and writes a partial report, a second one exits right away.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	if *reposFile != "" {
+		repos, err := lic.ReadReposFile(*reposFile)
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+		portfolio := lic.Portfolio{Launch: launcher, Repos: repos}
+		err = portfolio.LaunchPortfolioContext(ctx)
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+		return
 	}
-	err := launcher.LaunchProgram()
+	err := launcher.LaunchProgramContext(ctx)
 	if err != nil {
 		log.Println(err)
-		return
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/JCPrice0024/lic-col/src/lic"
+)
+
+// runDefinitions runs the definitions command and returns the exit code.
+func runDefinitions(args []string) int {
+	if len(args) == 0 || args[0] != "add" {
+		log.Println("usage: licenseChecker definitions add -file=LICENSE -name=\"Example License\" [-spdx=id]")
+		return 2
+	}
+	addFlags := flag.NewFlagSet("definitions add", flag.ExitOnError)
+	file := addFlags.String("file", "", "The file flag is a sample text of the license")
+	name := addFlags.String("name", "", "The name flag is the name of the license as it will appear in the results")
+	spdx := addFlags.String("spdx", "", "The spdx flag is the SPDX identifier of the license")
+	category := addFlags.String("category", "", "The category flag is the category of the license, if empty the category of the SPDX identifier is used")
+	lines := addFlags.Int("lines", 3, "The lines flag is the number of lines picked from the sample")
+	addFlags.Parse(args[1:])
+
+	if *file == "" || *name == "" {
+		addFlags.PrintDefaults()
+		return 2
+	}
+	bs, err := os.ReadFile(*file)
+	if err != nil {
+		log.Println(err)
+		return 2
+	}
+	def, path, err := lic.AddDefinition(os.Getenv("GOPATH"), bs, *name, *spdx, *category, *lines)
+	if err != nil {
+		log.Println(err)
+		return 1
+	}
+	log.Printf("Added %s to %s with the lines:\n%s", def.Name, path, strings.Join(def.Lines, "\n"))
+	return 0
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/JCPrice0024/lic-col/src/lic"
+)
+
+// runExplain runs the explain command and returns the exit code.
+func runExplain(args []string) int {
+	explainFlags := flag.NewFlagSet("explain", flag.ExitOnError)
+	top := explainFlags.Int("top", 5, "The top flag is the number of licenses to show")
+	context := explainFlags.Int("context", 8, "The context flag is the number of unchanged words shown around each change of the diff")
+	explainFlags.Parse(args)
+
+	if explainFlags.NArg() != 1 {
+		log.Println("usage: licenseChecker explain [-top=5] [-context=8] file")
+		explainFlags.PrintDefaults()
+		return 2
+	}
+	bs, err := os.ReadFile(explainFlags.Arg(0))
+	if err != nil {
+		log.Println(err)
+		return 2
+	}
+	defLicenses, err := lic.InitLicense(os.Getenv("GOPATH"))
+	if err != nil {
+		log.Println(err)
+		return 2
+	}
+	for i, e := range lic.Explain(defLicenses, bs, *top) {
+		status := "no match"
+		if e.Matched {
+			status = "match"
+		}
+		fmt.Printf("%d. %s (%s) %s score %.2f: %s\n", i+1, e.License, e.SPDX, e.Kind, e.Score, status)
+		for _, line := range e.Lines {
+			mark := "-"
+			if line.Matched {
+				mark = "+"
+			}
+			fmt.Printf("   %s %s\n", mark, line.Line)
+		}
+		if len(e.Diff) > 0 {
+			fmt.Printf("   Diff of the nearest miss against the file, [-missing-] {+extra+}:\n   %s\n", lic.FormatDiff(e.Diff, *context))
+		}
+	}
+	return 0
+}
@@ -10,6 +10,14 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "explain":
+			os.Exit(runExplain(os.Args[2:]))
+		case "definitions":
+			os.Exit(runDefinitions(os.Args[2:]))
+		}
+	}
 
 	defLicenses, err := lic.InitLicense(os.Getenv("GOPATH"))
 	if err != nil {
@@ -0,0 +1,321 @@
+package lic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// Options tells Scan what to scan. Either Dir or Repo has to be set, if both are set Dir is used.
+type Options struct {
+	Dir            string // Local checkout of the project, it is scanned in place.
+	Name           string // Name of the project in the form host/owner/reponame, the base of Dir if empty.
+	Repo           string // Git repo to clone into a temporary directory and scan.
+	Ref            string // Branch, tag or commit hash of Repo to scan.
+	GoProxy        string // If set modules are fetched from this GOPROXY list instead of using go mod download.
+	ModPath        string // Module cache holding the downloaded modules, GOPATH/pkg/mod if empty.
+	ProjectLicense string // Outbound license of the project, detected from the scanned project if empty.
+	HTML           bool   // The license copies written by WriteLicenses and WriteReport are html pages.
+	Config         []ConfigOption
+}
+
+// ConfigOption changes where Scan gets its config from. Without options the config files are read
+// the same way as by the command line, from GOPATH or the DES_* environment variables.
+type ConfigOption func(*scanConfig)
+
+// scanConfig is the config of a Scan built from its ConfigOptions.
+type scanConfig struct {
+	paths       configPaths
+	noSPDXList  bool
+	classifiers []Classifier
+}
+
+// WithConfigDir reads every config file from dir instead of the Config folder of lic-col. Files that
+// don't exist in dir are treated as empty.
+func WithConfigDir(dir string) ConfigOption {
+	return func(c *scanConfig) {
+		for name := range c.paths {
+			c.paths[name] = filepath.Join(dir, name)
+		}
+	}
+}
+
+// withConfigFile reads the config file name from path.
+func withConfigFile(name, path string) ConfigOption {
+	return func(c *scanConfig) {
+		c.paths[name] = path
+	}
+}
+
+// WithLicensesFile reads the defined licenses from path instead of definedlicenses.json.
+func WithLicensesFile(path string) ConfigOption {
+	return withConfigFile(definedJson, path)
+}
+
+// WithExceptionsFile reads the license exceptions from path instead of definedexceptions.json.
+func WithExceptionsFile(path string) ConfigOption {
+	return withConfigFile(exceptionsJson, path)
+}
+
+// WithExclusionsFile reads the excluded filenames from path instead of excludedfiles.json.
+func WithExclusionsFile(path string) ConfigOption {
+	return withConfigFile(exclusionsJson, path)
+}
+
+// WithExcludedExtensionsFile reads the excluded file extensions from path instead of excludedextensions.json.
+func WithExcludedExtensionsFile(path string) ConfigOption {
+	return withConfigFile(excludedEXTJson, path)
+}
+
+// WithInclusionsFile reads the included filenames from path instead of includedfiles.json.
+func WithInclusionsFile(path string) ConfigOption {
+	return withConfigFile(inclusionsJson, path)
+}
+
+// WithOverridesFile reads the license overrides from path instead of overridelicense.json.
+func WithOverridesFile(path string) ConfigOption {
+	return withConfigFile(overrideJson, path)
+}
+
+// WithPolicyFile reads the license policy from path instead of policy.json.
+func WithPolicyFile(path string) ConfigOption {
+	return withConfigFile(policyJson, path)
+}
+
+// WithCompatibilityFile reads the compatibility matrix from path instead of compatibility.json.
+func WithCompatibilityFile(path string) ConfigOption {
+	return withConfigFile(compatibilityJson, path)
+}
+
+// WithoutSPDXList only matches the defined licenses, not the embedded SPDX license list.
+func WithoutSPDXList() ConfigOption {
+	return func(c *scanConfig) {
+		c.noSPDXList = true
+	}
+}
+
+// WithClassifier asks the classifier before the registered and built-in classifiers.
+func WithClassifier(classifier Classifier) ConfigOption {
+	return func(c *scanConfig) {
+		c.classifiers = append(c.classifiers, classifier)
+	}
+}
+
+// errNoScan is returned by the Write functions that need the scan of a Report that wasn't made by Scan,
+// like a Report decoded from json.
+var errNoScan = errors.New("error writing report: the report wasn't made by Scan")
+
+// Report is the result of Scan. It is written with the Write functions.
+type Report struct {
+	Repo           string       `json:",omitempty"`
+	Ref            string       `json:",omitempty"`
+	Commit         string       `json:",omitempty"` // Commit hash that was scanned, empty if the project isn't a git repo.
+	Project        ModuleResult // Licenses of the scanned project itself.
+	Modules        []ModuleResult
+	ProjectLicense string     `json:",omitempty"` // Outbound license the Conflicts were checked against.
+	Conflicts      []Conflict `json:",omitempty"`
+	scanner        *Scanner
+	conflicts      conflictsReport
+}
+
+// Scan scans a project and the modules of its go.sum files and returns the report instead of writing
+// it. Without GoProxy missing modules are downloaded with go mod download, which writes them into the
+// module cache. Nothing is read from Standard Input, the GitHub API isn't used.
+func Scan(ctx context.Context, opts Options) (*Report, error) {
+	if opts.Dir == "" && opts.Repo == "" {
+		return nil, errors.New("error scanning: no Dir or Repo")
+	}
+	gopath := os.Getenv("GOPATH")
+	if gopath == "" {
+		return nil, errors.New("no GOPATH found")
+	}
+	cfg := scanConfig{paths: defaultConfigPaths(gopath)}
+	for _, opt := range opts.Config {
+		opt(&cfg)
+	}
+	scan, err := newScanner(cfg.paths)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.noSPDXList {
+		scan.Licenses = scan.Licenses.withoutTemplates()
+	}
+	scan.Classifiers = append(cfg.classifiers, scan.Classifiers...)
+	scan.Gopath = gopath
+	scan.ModPath = opts.ModPath
+	if scan.ModPath == "" {
+		scan.ModPath = filepath.Join(gopath, "pkg", "mod")
+	}
+	scan.ToHTML = opts.HTML
+	scan.InMemory = true
+	if opts.GoProxy != "" {
+		scan.Fetcher, err = newProxyFetcher(opts.GoProxy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	l := &Launch{Repo: opts.Repo, Ref: opts.Ref, GoProxy: opts.GoProxy, ProjectLicense: opts.ProjectLicense,
+		Gopath: gopath, ModPath: scan.ModPath, Scanner: *scan}
+
+	clone := opts.Dir
+	if clone == "" {
+		clone, err = l.tempClone(ctx)
+		defer l.removeTempClone()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		clone, err = filepath.Abs(clone)
+		if err != nil {
+			return nil, fmt.Errorf("error getting absolute path: %w", err)
+		}
+		l.Scanner.CloneDir = clone
+		l.Scanner.CloneName = opts.Name
+		if l.Scanner.CloneName == "" {
+			l.Scanner.CloneName = filepath.Base(clone)
+		}
+		if l.resolveCommit(ctx, clone) != nil {
+			log.Println("Not a git repo, no commit recorded: ", clone)
+		}
+	}
+	l.Scanner.LicFolder = filepath.Base(clone) + "_" + "Licenses"
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	l.Scanner.startProject(clone)
+	err = l.Scanner.walkFS(ctx, os.DirFS(clone), clone)
+	if err != nil {
+		return nil, err
+	}
+	l.Scanner.finishProject()
+	err = walkContext(ctx, clone, l.sumWalk(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	compat, err := readCompatibility(cfg.paths[compatibilityJson])
+	if err != nil {
+		return nil, err
+	}
+	s := &l.Scanner
+	sortLicenseTypes(s.LicenseType)
+	report := &Report{Repo: opts.Repo, Ref: opts.Ref, Commit: l.Commit, Project: s.moduleResult(s.Project),
+		Modules: s.moduleResults(), scanner: s}
+	if conflicts, ok := s.conflicts(compat, opts.ProjectLicense); ok {
+		report.conflicts = conflicts
+		report.ProjectLicense = conflicts.ProjectLicense
+		report.Conflicts = conflicts.Conflicts
+	}
+	return report, nil
+}
+
+// WriteJSON writes the whole report as json.
+func WriteJSON(w io.Writer, r *Report) error {
+	return writeJson(w, r, "report")
+}
+
+// WriteModules writes the result of every module as json, the same as the modules.json of a scan.
+func WriteModules(w io.Writer, r *Report) error {
+	return writeJson(w, r.Modules, "modules")
+}
+
+// WriteLicenseTypes writes the license files found by license as json, the same as the
+// licensetypes.json of a scan.
+func WriteLicenseTypes(w io.Writer, r *Report) error {
+	if r.scanner == nil {
+		return errNoScan
+	}
+	return writeJson(w, r.scanner.LicenseType, "licenseTypes")
+}
+
+// WriteAttribution writes the copyright holders and license text copies of every module, the same as the
+// attribution.txt of a scan.
+func WriteAttribution(w io.Writer, r *Report) error {
+	if r.scanner == nil {
+		return errNoScan
+	}
+	return writeAttribution(w, *r.scanner)
+}
+
+// WriteLicenses writes a copy of every license file found into the Licenses folder of out, as html
+// pages if Options.HTML was set.
+func WriteLicenses(out Sink, r *Report) error {
+	s, err := r.outputScanner(out)
+	if err != nil {
+		return err
+	}
+	mods := s.modulesInOrder()
+	if s.Project != nil {
+		mods = append([]*scannedModule{s.Project}, mods...)
+	}
+	for _, mod := range mods {
+		for _, c := range mod.Copies {
+			err := s.copyLicense(c.Path, c.Data)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteReport writes every file a scan of the command line writes into out: the license copies, the
+// json reports, the attribution and, if Options.HTML was set, the html index. Use DirSink to write
+// into a directory.
+func WriteReport(out Sink, r *Report) error {
+	err := WriteLicenses(out, r)
+	if err != nil {
+		return err
+	}
+	s, err := r.outputScanner(out)
+	if err != nil {
+		return err
+	}
+	err = s.createScanFiles()
+	if err != nil {
+		return err
+	}
+	l := &Launch{Repo: r.Repo, Ref: r.Ref, Commit: r.Commit, Output: out, Scanner: *s}
+	if r.ProjectLicense != "" {
+		err = l.createReportFile(conflictsFile, func(w io.Writer) error {
+			return writeJson(w, r.conflicts, "conflicts")
+		})
+		if err != nil {
+			return err
+		}
+	}
+	err = l.createMetadataFile()
+	if err != nil {
+		return err
+	}
+	if s.ToHTML {
+		err = l.createHtmlIndex()
+		if err != nil {
+			return err
+		}
+		err = l.createHtmlModules()
+		if err != nil {
+			return err
+		}
+		return l.createHtmlReport()
+	}
+	return nil
+}
+
+// outputScanner gets a copy of the scanner of the report that writes into out.
+func (r *Report) outputScanner(out Sink) (*Scanner, error) {
+	if r.scanner == nil {
+		return nil, errNoScan
+	}
+	s := *r.scanner
+	s.Output = out
+	s.LicFolder = ""
+	s.InMemory = false
+	s.CurrentModule = nil
+	return &s, nil
+}
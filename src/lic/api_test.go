@@ -0,0 +1,92 @@
+package lic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	testGopath(t)
+	proxy := newTestProxy(t)
+	proxy.add("example.com/mit", "v1.0.0", map[string]string{"LICENSE": testMIT})
+	proxy.add("example.com/acme", "v1.0.0", map[string]string{"LICENSE": testAcme})
+	project := t.TempDir()
+	os.WriteFile(filepath.Join(project, "go.sum"), []byte(proxy.Sum), os.ModePerm)
+	os.WriteFile(filepath.Join(project, "LICENSE"), []byte(testZlib), os.ModePerm)
+	config := t.TempDir()
+	os.WriteFile(filepath.Join(config, definedJson), []byte(`[{"Name": "Acme", "Lines": ["signed distribution agreement with the Acme legal department"]}]`), os.ModePerm)
+
+	opts := Options{Dir: project, Name: "example.com/project", GoProxy: proxy.URL(),
+		Config: []ConfigOption{WithConfigDir(config)}}
+	report, err := Scan(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Project.Conclusion != "zlib License" || len(report.Modules) != 2 {
+		t.Fatalf("Unexpected report: %+v", report)
+	}
+	for _, mod := range report.Modules {
+		want := map[string]string{"example.com/mit": "MIT License", "example.com/acme": "Acme"}[mod.Module]
+		if mod.Conclusion != want || len(mod.Files) != 1 || mod.Files[0].Classifier == "" {
+			t.Errorf("Unexpected module result: %+v", mod)
+		}
+	}
+
+	dst := t.TempDir()
+	err = WriteReport(DirSink(dst), report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{licTypesFile, modulesFile, attributionFile, metadataFile, textsFile, "Licenses/" + licenseHash([]byte(testAcme))} {
+		if _, err := os.Stat(filepath.Join(dst, name)); err != nil {
+			t.Errorf("Expected %s to be written: %v", name, err)
+		}
+	}
+	buf := new(bytes.Buffer)
+	err = WriteModules(buf, report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	written, _ := os.ReadFile(filepath.Join(dst, modulesFile))
+	if !bytes.Equal(buf.Bytes(), written) {
+		t.Errorf("WriteModules should write modules.json:\n%s", buf)
+	}
+	if entries, _ := os.ReadDir(project); len(entries) != 2 {
+		t.Errorf("Scan shouldn't write into the project: %v", entries)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Scan(ctx, opts)
+	if err == nil || !strings.Contains(err.Error(), "canceled") {
+		t.Errorf("A canceled scan should fail: %v", err)
+	}
+	if _, err := Scan(context.Background(), Options{}); err == nil {
+		t.Error("A scan without Dir or Repo should fail")
+	}
+
+	decoded := &Report{}
+	err = json.Unmarshal([]byte(`{"Modules": [{"Module": "example.com/mit"}]}`), decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteModules(new(bytes.Buffer), decoded); err != nil {
+		t.Errorf("The modules of a decoded report should be written: %v", err)
+	}
+	for name, write := range map[string]func() error{
+		"WriteLicenseTypes": func() error { return WriteLicenseTypes(new(bytes.Buffer), decoded) },
+		"WriteAttribution":  func() error { return WriteAttribution(new(bytes.Buffer), decoded) },
+		"WriteLicenses":     func() error { return WriteLicenses(NewMemSink(), decoded) },
+		"WriteReport":       func() error { return WriteReport(NewMemSink(), decoded) },
+	} {
+		if err := write(); !errors.Is(err, errNoScan) {
+			t.Errorf("%s of a report that wasn't made by Scan should fail: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package lic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"time"
+)
+
+// ErrNewFindings is returned by a scan with a baseline if there are findings the baseline doesn't cover.
+var ErrNewFindings = errors.New("findings not covered by the baseline")
+
+// findingsFile is the json file in the LicFolder that lists the findings of a scan with a baseline.
+const findingsFile = "findings.json"
+
+// baselineDate is the format of the dates in a baseline file.
+const baselineDate = "2006-01-02"
+
+// baseline is a struct that holds the findings that were reviewed and accepted.
+type baseline struct {
+	Accepted []baselineEntry
+}
+
+// baselineEntry is a single accepted module@version to license decision. An entry stops covering
+// the finding after Expires if it is set.
+type baselineEntry struct {
+	Module   string
+	Version  string
+	License  string
+	Reviewer string
+	Date     string
+	Expires  string `json:",omitempty"`
+}
+
+// finding is a module whose license isn't allowed by the policy, this includes the Unknown License
+// and No License results.
+type finding struct {
+	Module  string
+	Version string
+	License string
+	Status  string
+}
+
+// findingsReport is the struct written to the findingsFile.
+type findingsReport struct {
+	New      []finding
+	Accepted []finding
+	Expired  []finding
+}
+
+// loadBaseline reads a baseline file, a missing file is an empty baseline.
+func loadBaseline(filename string) (baseline, error) {
+	b := baseline{}
+	err := initJsonConfigs(filename, &b)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return b, fmt.Errorf("error reading baseline: %w", err)
+	}
+	return b, nil
+}
+
+// save writes the baseline file sorted by module, version and license.
+func (b baseline) save(filename string) error {
+	sort.Slice(b.Accepted, func(i, j int) bool {
+		a, c := b.Accepted[i], b.Accepted[j]
+		if a.Module != c.Module {
+			return a.Module < c.Module
+		}
+		if a.Version != c.Version {
+			return a.Version < c.Version
+		}
+		return a.License < c.License
+	})
+	bs, err := json.MarshalIndent(b, "", "   ")
+	if err != nil {
+		return fmt.Errorf("error marshaling baseline: %w", err)
+	}
+	return os.WriteFile(filename, bs, os.ModePerm)
+}
+
+// find gets the entry covering the finding, it returns nil if there is none.
+func (b baseline) find(f finding) *baselineEntry {
+	for i, e := range b.Accepted {
+		if e.Module == f.Module && e.Version == f.Version && e.License == f.License {
+			return &b.Accepted[i]
+		}
+	}
+	return nil
+}
+
+// expired is true if the entry has an expiry date that has passed.
+func (e baselineEntry) expired(now time.Time) bool {
+	if e.Expires == "" {
+		return false
+	}
+	exp, err := time.Parse(baselineDate, e.Expires)
+	if err != nil {
+		log.Printf("Invalid baseline expiry, treating entry as expired: %s@%s %s", e.Module, e.Version, e.Expires)
+		return true
+	}
+	return !now.Before(exp)
+}
+
+// getFindings gets every module in the LicenseType whose license isn't allowed by the policy.
+func getFindings(lt map[string][]LicenseInfo, pol policy) []finding {
+	var findings []finding
+	mods := modulesFromLicenseTypes(lt)
+	for mod, vers := range mods {
+		for ver, lics := range vers {
+			for _, lic := range lics {
+				status := pol.evaluate(lic)
+				if status != statusAllowed {
+					findings = append(findings, finding{Module: mod, Version: ver, License: lic, Status: status})
+				}
+			}
+		}
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Module != b.Module {
+			return a.Module < b.Module
+		}
+		if a.Version != b.Version {
+			return compareVersions(a.Version, b.Version) < 0
+		}
+		return a.License < b.License
+	})
+	return findings
+}
+
+// checkBaseline compares the findings of the scan with the baseline, writes the findingsFile and
+// returns ErrNewFindings if any finding isn't covered.
+func (l *Launch) checkBaseline() error {
+	b, err := loadBaseline(l.Baseline)
+	if err != nil {
+		return err
+	}
+	report := findingsReport{}
+	now := time.Now()
+	for _, f := range getFindings(l.Scanner.LicenseType, l.Scanner.Policy) {
+		e := b.find(f)
+		switch {
+		case e == nil:
+			report.New = append(report.New, f)
+		case e.expired(now):
+			report.Expired = append(report.Expired, f)
+		default:
+			report.Accepted = append(report.Accepted, f)
+		}
+	}
+	err = l.createReportFile(findingsFile, func(w io.Writer) error {
+		return writeJson(w, report, "findings")
+	})
+	if err != nil {
+		return fmt.Errorf("error writing findings: %w", err)
+	}
+	log.Printf("Findings: %d accepted by the baseline, %d new, %d expired", len(report.Accepted), len(report.New), len(report.Expired))
+	for _, f := range append(report.New, report.Expired...) {
+		log.Printf("Finding not covered by the baseline: %s@%s %s (%s)", f.Module, f.Version, f.License, f.Status)
+	}
+	if len(report.New)+len(report.Expired) > 0 {
+		return fmt.Errorf("%w: %d", ErrNewFindings, len(report.New)+len(report.Expired))
+	}
+	return nil
+}
+
+// UpdateBaseline refreshes the baseline file from the LicTypesFile of a scan. Entries of findings that
+// still exist are kept as they are, new findings are added with the reviewer and today's date and
+// entries that no longer match a finding are removed. Expired entries are replaced like new findings,
+// so they don't stay in the baseline once the reviewer accepts the finding again. expires is optional.
+func UpdateBaseline(baselineFile, typesFile, reviewer, expires string) (added int, removed int, err error) {
+	if expires != "" {
+		_, err = time.Parse(baselineDate, expires)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid expiry date, expected YYYY-MM-DD: %w", err)
+		}
+	}
+	lt, err := LoadLicenseTypes(typesFile)
+	if err != nil {
+		return 0, 0, err
+	}
+	pol, err := initPolicy(os.Getenv("GOPATH"))
+	if err != nil {
+		return 0, 0, err
+	}
+	old, err := loadBaseline(baselineFile)
+	if err != nil {
+		return 0, 0, err
+	}
+	updated := baseline{}
+	now := time.Now()
+	today := now.Format(baselineDate)
+	kept := 0
+	for _, f := range getFindings(lt, pol) {
+		e := old.find(f)
+		if e != nil && !e.expired(now) {
+			updated.Accepted = append(updated.Accepted, *e)
+			kept++
+			continue
+		}
+		if e != nil {
+			log.Printf("Replacing expired baseline entry: %s@%s %s (expired %s)", e.Module, e.Version, e.License, e.Expires)
+		}
+		added++
+		updated.Accepted = append(updated.Accepted, baselineEntry{
+			Module:   f.Module,
+			Version:  f.Version,
+			License:  f.License,
+			Reviewer: reviewer,
+			Date:     today,
+			Expires:  expires,
+		})
+	}
+	removed = len(old.Accepted) - kept
+	return added, removed, updated.save(baselineFile)
+}
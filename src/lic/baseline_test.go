@@ -0,0 +1,59 @@
+package lic
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBaseline(t *testing.T) {
+	testGopath(t)
+	dir := t.TempDir()
+	lt := map[string][]LicenseInfo{
+		unknownLicense: {{Filename: "example.com/reviewed@v1.0.0/LICENSE"}, {Filename: "example.com/new@v1.0.0/LICENSE"}},
+		noLicense:      {{Filename: "example.com/expired@v0.1.0"}},
+		"MIT":          {{Filename: "example.com/fine@v1.0.0/LICENSE"}},
+	}
+	bs, _ := json.Marshal(lt)
+	typesFile := filepath.Join(dir, licTypesFile)
+	os.WriteFile(typesFile, bs, os.ModePerm)
+	baselineFile := filepath.Join(dir, "baseline.json")
+	b := baseline{Accepted: []baselineEntry{
+		{Module: "example.com/reviewed", Version: "v1.0.0", License: unknownLicense, Reviewer: "legal", Date: "2026-01-01"},
+		{Module: "example.com/expired", Version: "v0.1.0", License: noLicense, Reviewer: "legal", Date: "2025-01-01", Expires: "2025-06-01"},
+		{Module: "example.com/stale", Version: "v1.0.0", License: noLicense, Reviewer: "legal", Date: "2025-01-01"},
+	}}
+	b.save(baselineFile)
+
+	launcher := Launch{Dst: dir, Baseline: baselineFile, Scanner: Scanner{LicenseType: lt, LicFolder: "repo_Licenses"}}
+	err := launcher.checkBaseline()
+	if !errors.Is(err, ErrNewFindings) {
+		t.Fatalf("Expected new findings got: %v", err)
+	}
+	report := findingsReport{}
+	bs, _ = os.ReadFile(filepath.Join(dir, "repo_Licenses", findingsFile))
+	json.Unmarshal(bs, &report)
+	if len(report.New) != 1 || report.New[0].Module != "example.com/new" || len(report.Accepted) != 1 || len(report.Expired) != 1 {
+		t.Fatalf("Unexpected findings: %+v", report)
+	}
+
+	added, removed, err := UpdateBaseline(baselineFile, typesFile, "reviewer", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 2 || removed != 2 {
+		t.Fatalf("Expected 2 added and 2 removed GOT: %d %d", added, removed)
+	}
+	updated, _ := loadBaseline(baselineFile)
+	if e := updated.find(finding{Module: "example.com/reviewed", Version: "v1.0.0", License: unknownLicense}); e == nil || e.Reviewer != "legal" {
+		t.Fatalf("Expected reviewed entry to be kept: %+v", updated)
+	}
+	if e := updated.find(finding{Module: "example.com/expired", Version: "v0.1.0", License: noLicense}); e == nil || e.Reviewer != "reviewer" || e.Expires != "" {
+		t.Fatalf("Expected the expired entry to be replaced: %+v", updated)
+	}
+	if len(updated.Accepted) != 3 {
+		t.Fatalf("Expected one entry per finding: %+v", updated)
+	}
+}
@@ -0,0 +1,223 @@
+package lic
+
+import (
+	"io"
+	"log"
+	"sort"
+	"strings"
+)
+
+// obligationsFile is the json file in the LicFolder that summarizes the categories and obligations of
+// every module.
+const obligationsFile = "obligations.json"
+
+// The license categories, ordered from least to most restrictive.
+const (
+	categoryPublicDomain    = "public-domain"
+	categoryPermissive      = "permissive"
+	categoryWeakCopyleft    = "weak-copyleft"
+	categoryStrongCopyleft  = "strong-copyleft"
+	categoryNetworkCopyleft = "network-copyleft"
+	categoryNonCommercial   = "non-commercial"
+	categoryProprietary     = "proprietary"
+)
+
+// The obligations a license can have.
+const (
+	obligationAttribution    = "attribution"
+	obligationStateChanges   = "state-changes"
+	obligationDiscloseSource = "disclose-source"
+	obligationSameLicense    = "same-license"
+	obligationPatentGrant    = "patent-grant"
+)
+
+// categoryOrder ranks the categories, a higher rank is more restrictive.
+var categoryOrder = []string{
+	categoryPublicDomain,
+	categoryPermissive,
+	categoryWeakCopyleft,
+	categoryStrongCopyleft,
+	categoryNetworkCopyleft,
+	categoryNonCommercial,
+	categoryProprietary,
+}
+
+// obligationOrder lists the known obligations.
+var obligationOrder = []string{
+	obligationAttribution,
+	obligationStateChanges,
+	obligationDiscloseSource,
+	obligationSameLicense,
+	obligationPatentGrant,
+}
+
+// spdxTerms are the categories and obligations of the licenses of the embedded SPDX license list,
+// they are used for the licenses definedlicenses.json doesn't define.
+var spdxTerms = map[string]definedLicense{
+	"0BSD":                {Category: categoryPermissive},
+	"AFL-3.0":             {Category: categoryPermissive, Obligations: []string{obligationAttribution, obligationPatentGrant}},
+	"AGPL-3.0-only":       {Category: categoryNetworkCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"Apache-1.0":          {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"Apache-1.1":          {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"Apache-2.0":          {Category: categoryPermissive, Obligations: []string{obligationAttribution, obligationStateChanges, obligationPatentGrant}},
+	"Artistic-2.0":        {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationPatentGrant}},
+	"BSD-2-Clause":        {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"BSD-2-Clause-Patent": {Category: categoryPermissive, Obligations: []string{obligationAttribution, obligationPatentGrant}},
+	"BSD-3-Clause":        {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"BSD-3-Clause-Clear":  {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"BSD-4-Clause":        {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"BSL-1.0":             {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"BUSL-1.1":            {Category: categoryProprietary, Obligations: []string{obligationAttribution}},
+	"CC-BY-4.0":           {Category: categoryPermissive, Obligations: []string{obligationAttribution, obligationStateChanges}},
+	"CC-BY-NC-4.0":        {Category: categoryNonCommercial, Obligations: []string{obligationAttribution, obligationStateChanges}},
+	"CC-BY-NC-SA-4.0":     {Category: categoryNonCommercial, Obligations: []string{obligationAttribution, obligationStateChanges, obligationSameLicense}},
+	"CC-BY-ND-4.0":        {Category: categoryProprietary, Obligations: []string{obligationAttribution}},
+	"CC-BY-SA-4.0":        {Category: categoryStrongCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationSameLicense}},
+	"CC0-1.0":             {Category: categoryPublicDomain},
+	"CDDL-1.0":            {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"CDDL-1.1":            {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"CPL-1.0":             {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"Elastic-2.0":         {Category: categoryProprietary, Obligations: []string{obligationAttribution}},
+	"EPL-1.0":             {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"EPL-2.0":             {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"EUPL-1.1":            {Category: categoryStrongCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"EUPL-1.2":            {Category: categoryStrongCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"GFDL-1.3-only":       {Category: categoryStrongCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense}},
+	"GPL-1.0-only":        {Category: categoryStrongCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense}},
+	"GPL-2.0-only":        {Category: categoryStrongCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense}},
+	"GPL-3.0-only":        {Category: categoryStrongCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"ISC":                 {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"LGPL-2.0-only":       {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense}},
+	"LGPL-2.1-only":       {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense}},
+	"LGPL-3.0-only":       {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"MIT":                 {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"MIT-0":               {Category: categoryPermissive},
+	"MPL-1.0":             {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationDiscloseSource, obligationSameLicense}},
+	"MPL-1.1":             {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"MPL-2.0":             {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"MS-PL":               {Category: categoryPermissive, Obligations: []string{obligationAttribution, obligationPatentGrant}},
+	"MS-RL":               {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationDiscloseSource, obligationSameLicense, obligationPatentGrant}},
+	"NCSA":                {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"OFL-1.1":             {Category: categoryWeakCopyleft, Obligations: []string{obligationAttribution, obligationSameLicense}},
+	"OpenSSL":             {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"PostgreSQL":          {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"Python-2.0":          {Category: categoryPermissive, Obligations: []string{obligationAttribution, obligationStateChanges}},
+	"SSPL-1.0":            {Category: categoryNetworkCopyleft, Obligations: []string{obligationAttribution, obligationStateChanges, obligationDiscloseSource, obligationSameLicense}},
+	"Unicode-DFS-2016":    {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"Unlicense":           {Category: categoryPublicDomain},
+	"UPL-1.0":             {Category: categoryPermissive, Obligations: []string{obligationAttribution, obligationPatentGrant}},
+	"W3C":                 {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"WTFPL":               {Category: categoryPublicDomain},
+	"X11":                 {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+	"Zlib":                {Category: categoryPermissive, Obligations: []string{obligationStateChanges}},
+	"ZPL-2.1":             {Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+}
+
+// obligationsReport is the struct written to the obligationsFile, both maps list the modules
+// (module@version) that have the category or obligation.
+type obligationsReport struct {
+	Categories  map[string][]string
+	Obligations map[string][]string
+}
+
+// categoryRank gets the rank of a category, unknown categories are -1.
+func categoryRank(category string) int {
+	for i, c := range categoryOrder {
+		if c == category {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkTerms logs the categories and obligations of a definition that lic-col doesn't know, they
+// are still reported but policies can't rank them.
+func checkTerms(def definedLicense) {
+	if def.Category != "" && categoryRank(def.Category) < 0 {
+		log.Printf("Unknown license category: license: %s category: %s", def.Name, def.Category)
+	}
+	for _, o := range def.Obligations {
+		known := false
+		for _, k := range obligationOrder {
+			known = known || k == o
+		}
+		if !known {
+			log.Printf("Unknown license obligation: license: %s obligation: %s", def.Name, o)
+		}
+	}
+}
+
+// find gets the definition of a license by its name or SPDX identifier.
+func (l licenses) find(name string) (definedLicense, bool) {
+	for _, def := range l {
+		if def.Name == name || (def.SPDX != "" && def.SPDX == name) {
+			return def, true
+		}
+	}
+	return definedLicense{}, false
+}
+
+// licenseTerms gets the category and obligations of a license name as it appears in the LicenseType.
+// The category of an exception replaces the category of its license, overrides use the category of
+// the license they declare. Licenses that aren't defined have no category.
+func licenseTerms(lics, excs licenses, name string) (string, []string) {
+	name = strings.TrimSuffix(name, overrideSuffix)
+	lic, exc, hasExc := strings.Cut(name, withOperator)
+	def, ok := lics.find(lic)
+	if !ok {
+		return "", nil
+	}
+	category := def.Category
+	if hasExc {
+		excDef, ok := excs.find(exc)
+		if ok && excDef.Category != "" {
+			category = excDef.Category
+		}
+	}
+	return category, def.Obligations
+}
+
+// moduleTerms gets the most restrictive category and every obligation of the licenses that apply
+// to a module, of the alternatives of a dual-licensed module only the one the policy prefers is used.
+func (s *Scanner) moduleTerms(alternatives, required []string) (string, []string) {
+	lics := withoutCovered(required)
+	if len(alternatives) > 0 {
+		lics = append(lics, s.Policy.bestAlternative(alternatives))
+	}
+	category := ""
+	var obligations []string
+	for _, lic := range lics {
+		c, obs := licenseTerms(s.Licenses, s.Exceptions, lic)
+		if categoryRank(c) > categoryRank(category) {
+			category = c
+		}
+		for _, o := range obs {
+			obligations = appendUnique(obligations, o)
+		}
+	}
+	sort.Strings(obligations)
+	return category, obligations
+}
+
+// writeObligations writes the summary of the obligations of the module results as json.
+func writeObligations(w io.Writer, results []ModuleResult) error {
+	report := obligationsReport{
+		Categories:  make(map[string][]string),
+		Obligations: make(map[string][]string),
+	}
+	for _, r := range results {
+		name := r.Module
+		if r.Version != "" {
+			name += "@" + r.Version
+		}
+		category := r.Category
+		if category == "" {
+			category = "uncategorized"
+		}
+		report.Categories[category] = append(report.Categories[category], name)
+		for _, o := range r.Obligations {
+			report.Obligations[o] = append(report.Obligations[o], name)
+		}
+	}
+	return writeJson(w, report, "obligations")
+}
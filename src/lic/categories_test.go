@@ -0,0 +1,35 @@
+package lic
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCategoryPolicy(t *testing.T) {
+	lics := licenses{
+		{Name: "MIT License", SPDX: "MIT", Category: categoryPermissive, Obligations: []string{obligationAttribution}},
+		{Name: "GPL v2", SPDX: "GPL-2.0-only", Category: categoryStrongCopyleft, Obligations: []string{obligationDiscloseSource, obligationSameLicense}},
+		{Name: "GPL v3", SPDX: "GPL-3.0-only", Category: categoryStrongCopyleft},
+	}
+	excs := licenses{{Name: "Classpath Exception 2.0", SPDX: "Classpath-exception-2.0", Category: categoryWeakCopyleft}}
+	pol := policy{Allow: []string{"GPL v3"}, AllowCategories: []string{categoryPermissive}, DenyCategories: []string{categoryStrongCopyleft}, licenses: lics, exceptions: excs}
+	for lic, want := range map[string]string{
+		"MIT License":                         statusAllowed,
+		"MIT":                                 statusAllowed, // SPDX headers are looked up by id.
+		"GPL v2":                              statusDenied,
+		"GPL v2 OVERRIDE":                     statusDenied,
+		"GPL v3":                              statusAllowed, // Names win over categories.
+		"GPL v2 WITH Classpath Exception 2.0": statusReview,
+		unknownLicense:                        statusReview,
+	} {
+		if got := pol.evaluate(lic); got != want {
+			t.Errorf("evaluate(%s) = %s, want %s", lic, got, want)
+		}
+	}
+
+	scan := Scanner{Licenses: lics, Exceptions: excs, Policy: pol}
+	category, obligations := scan.moduleTerms([]string{"GPL v2", "MIT License"}, []string{"GPL v2 WITH Classpath Exception 2.0"})
+	if category != categoryWeakCopyleft || !reflect.DeepEqual(obligations, []string{obligationAttribution, obligationDiscloseSource, obligationSameLicense}) {
+		t.Fatalf("Unexpected terms: %s %v", category, obligations)
+	}
+}
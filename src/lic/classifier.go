@@ -0,0 +1,204 @@
+package lic
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/JCPrice0024/lic-col/src/lic/spdxlicenses"
+)
+
+// similarityThreshold is the lowest similarity the similarity classifier reports.
+const similarityThreshold = 0.8
+
+// Classifier finds the licenses of a license file. The Scanner asks its Classifiers in order, the
+// first one that returns a candidate classifies the file.
+type Classifier interface {
+	Name() string
+	Classify(bs []byte, path string) []Candidate
+}
+
+// Candidate is a license a Classifier found in a file.
+type Candidate struct {
+	License    string  // Name of the license as it is listed in the LicenseType.
+	Confidence float64 // From 0 to 1, the candidate with the highest confidence is used.
+	Ranges     []Range // Parts of the file that matched the license, can be empty.
+}
+
+// Range is a byte range of a file, End is exclusive.
+type Range struct {
+	Start int
+	End   int
+}
+
+// registry holds the classifiers added with RegisterClassifier.
+var registry struct {
+	sync.Mutex
+	classifiers []Classifier
+}
+
+// RegisterClassifier adds a classifier to every Scanner created after the call. Registered classifiers
+// are asked before the built-in ones in the order they were registered.
+func RegisterClassifier(c Classifier) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.classifiers = append(registry.classifiers, c)
+}
+
+// registeredClassifiers gets a copy of the registered classifiers.
+func registeredClassifiers() []Classifier {
+	registry.Lock()
+	defer registry.Unlock()
+	return append([]Classifier{}, registry.classifiers...)
+}
+
+// classifiers gets the classifiers of the scanner followed by the built-in ones: the lines of
+// definedlicenses.json, the SPDX templates and the similarity to the SPDX templates.
+func (s *Scanner) classifiers() []Classifier {
+	return append(append([]Classifier{}, s.Classifiers...),
+		lineClassifier{licenses: s.Licenses},
+		templateClassifier{licenses: s.Licenses},
+		similarityClassifier{licenses: s.Licenses, threshold: similarityThreshold})
+}
+
+// classify gets the best candidate of the first classifier that finds a license in the file.
+func (s *Scanner) classify(bs []byte, path string) (Candidate, string, bool) {
+	for _, c := range s.classifiers() {
+		candidates := c.Classify(bs, path)
+		if len(candidates) == 0 {
+			continue
+		}
+		best := candidates[0]
+		for _, cand := range candidates[1:] {
+			if cand.Confidence > best.Confidence {
+				best = cand
+			}
+		}
+		return best, c.Name(), true
+	}
+	return Candidate{}, "", false
+}
+
+// lineClassifier is the classifier of the definitions with Lines, a license matches if the file contains
+// all of its lines. The definitions are checked in order and only the first match is returned.
+type lineClassifier struct {
+	licenses licenses
+}
+
+// Name gets the name of the classifier.
+func (c lineClassifier) Name() string {
+	return "lines"
+}
+
+// Classify checks the file against the lines of every definition.
+func (c lineClassifier) Classify(bs []byte, path string) []Candidate {
+	licDef, offsets := definitionOffsets(string(bs))
+	for _, def := range c.licenses {
+		if def.template != nil || !TestLicense(licDef, def, false) {
+			continue
+		}
+		var ranges []Range
+		for _, line := range def.Lines {
+			i := strings.Index(licDef, line)
+			if i >= 0 && line != "" {
+				ranges = append(ranges, Range{Start: offsets[i], End: offsets[i+len(line)-1] + 1})
+			}
+		}
+		return []Candidate{{License: def.Name, Confidence: 1, Ranges: ranges}}
+	}
+	return nil
+}
+
+// definitionOffsets formats a text like DefinitionFormat and gets the offset in the text of every
+// letter of the formatted text.
+func definitionOffsets(text string) (string, []int) {
+	var sb strings.Builder
+	var offsets []int
+	for i := 0; i < len(text); i++ {
+		b := text[i]
+		if b >= 'a' && b <= 'z' {
+			b -= 'a' - 'A'
+		}
+		if b >= 'A' && b <= 'Z' {
+			sb.WriteByte(b)
+			offsets = append(offsets, i)
+		}
+	}
+	return sb.String(), offsets
+}
+
+// templateClassifier is the classifier of the SPDX license templates, every template the file contains
+// is a candidate. The confidence grows with the size of the template so the most specific one is used.
+type templateClassifier struct {
+	licenses licenses
+}
+
+// Name gets the name of the classifier.
+func (c templateClassifier) Name() string {
+	return "spdx-template"
+}
+
+// Classify checks the file against every SPDX template.
+func (c templateClassifier) Classify(bs []byte, path string) []Candidate {
+	var words []string
+	var spans [][2]int
+	var set map[string]bool
+	largest := 0
+	for _, def := range c.licenses {
+		if def.template != nil && def.template.Size() > largest {
+			largest = def.template.Size()
+		}
+	}
+	var candidates []Candidate
+	for _, def := range c.licenses {
+		if def.template == nil {
+			continue
+		}
+		if words == nil {
+			words, spans = spdxlicenses.Spans(string(bs))
+			set = spdxlicenses.WordSet(words)
+		}
+		start, end, ok := def.template.Find(words, set)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, Candidate{
+			License:    def.Name,
+			Confidence: 0.9 + 0.1*float64(def.template.Size())/float64(largest),
+			Ranges:     []Range{{Start: spans[start][0], End: spans[end-1][1]}},
+		})
+	}
+	return candidates
+}
+
+// similarityClassifier is the classifier of license texts that were changed too much to match a template.
+// The confidence is the similarity of the file to an SPDX template, templates below the threshold are
+// left out.
+type similarityClassifier struct {
+	licenses  licenses
+	threshold float64
+}
+
+// Name gets the name of the classifier.
+func (c similarityClassifier) Name() string {
+	return "similarity"
+}
+
+// Classify compares the file to every SPDX template, the candidates are sorted by confidence.
+func (c similarityClassifier) Classify(bs []byte, path string) []Candidate {
+	words := spdxlicenses.Words(string(bs))
+	var candidates []Candidate
+	for _, def := range c.licenses {
+		if def.template == nil {
+			continue
+		}
+		similarity := def.template.Similarity(words)
+		if similarity >= c.threshold {
+			candidates = append(candidates, Candidate{License: def.Name, Confidence: similarity})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Confidence > candidates[j].Confidence
+	})
+	return candidates
+}
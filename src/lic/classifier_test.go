@@ -0,0 +1,83 @@
+package lic
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// headerClassifier recognizes a proprietary license header.
+type headerClassifier struct{}
+
+func (headerClassifier) Name() string {
+	return "acme-header"
+}
+
+func (headerClassifier) Classify(bs []byte, path string) []Candidate {
+	i := bytes.Index(bs, []byte("ACME PROPRIETARY"))
+	if i < 0 {
+		return nil
+	}
+	return []Candidate{{License: "Acme Proprietary", Confidence: 1, Ranges: []Range{{Start: i, End: i + len("ACME PROPRIETARY")}}}}
+}
+
+func TestRegisterClassifier(t *testing.T) {
+	saved := registeredClassifiers()
+	t.Cleanup(func() {
+		registry.Lock()
+		registry.classifiers = saved
+		registry.Unlock()
+	})
+	RegisterClassifier(headerClassifier{})
+
+	proxy := newTestProxy(t)
+	proxy.add("example.com/acme", "v1.0.0", map[string]string{
+		"LICENSE": "Copyright 2023 Acme Corp.\nACME PROPRIETARY - internal use only.",
+	})
+	proxy.add("example.com/mit", "v1.0.0", map[string]string{"LICENSE": testMIT})
+	scan := newProxyScanner(t, proxy, t.TempDir(), "classifier")
+	err := scan.ScanPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	infos := scan.LicenseType["Acme Proprietary"]
+	if len(infos) != 1 || infos[0].Classifier != "acme-header" || infos[0].Confidence != 1 {
+		t.Fatalf("The registered classifier should find the header: %v", scan.LicenseType)
+	}
+	if len(scan.LicenseType["MIT License"]) != 1 && len(scan.LicenseType["MIT"]) != 1 {
+		t.Fatalf("The built-in classifiers should still be used: %v", scan.LicenseType)
+	}
+}
+
+func TestBuiltinClassifiers(t *testing.T) {
+	lines := licenses{{Name: "MIT", Lines: []string{DefinitionFormat("Permission is hereby granted, free of charge")}}}
+	s := &Scanner{Licenses: append(lines, spdxDefinitions(lines)...)}
+
+	cand, classifier, ok := s.classify([]byte(testMIT), "LICENSE")
+	if !ok || classifier != "lines" || cand.License != "MIT" || len(cand.Ranges) != 1 {
+		t.Fatalf("Unexpected line candidate: %s %+v", classifier, cand)
+	}
+	r := cand.Ranges[0]
+	if got := testMIT[r.Start:r.End]; got != "Permission is hereby granted, free of charge" {
+		t.Errorf("Unexpected line range: %q", got)
+	}
+
+	cand, classifier, ok = s.classify([]byte(testZlib), "LICENSE")
+	if !ok || classifier != "spdx-template" || cand.License != "zlib License" {
+		t.Fatalf("Unexpected template candidate: %s %+v", classifier, cand)
+	}
+	if !strings.HasSuffix(testZlib[:cand.Ranges[0].End], "altered from any source distribution") {
+		t.Errorf("Unexpected template range: %+v", cand.Ranges)
+	}
+
+	// A changed zlib license doesn't match the template but is still similar to it.
+	changed := strings.Replace(testZlib, "must not be\n   misrepresented as being the original software.", "must say that they were changed.", 1)
+	cand, classifier, ok = s.classify([]byte(changed), "LICENSE")
+	if !ok || classifier != "similarity" || cand.License != "zlib License" || cand.Confidence >= 1 || cand.Confidence < similarityThreshold {
+		t.Fatalf("Unexpected similarity candidate: %s %+v", classifier, cand)
+	}
+
+	if _, _, ok := s.classify([]byte("Nothing to see here."), "LICENSE"); ok {
+		t.Error("Unrelated text should not be classified")
+	}
+}
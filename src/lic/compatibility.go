@@ -0,0 +1,215 @@
+package lic
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// compatibilityJson is the json file that holds the license compatibility matrix.
+const compatibilityJson = "compatibility.json"
+
+// conflictsFile is the json file in the LicFolder that lists the dependencies whose license is
+// incompatible with the project license.
+const conflictsFile = "conflicts.json"
+
+// licenseOperator splits a license expression at its AND and OR operators.
+var licenseOperator = regexp.MustCompile(`\s+(AND|OR)\s+`)
+
+// compatibilityRule says if code under the Inbound license can be used in a project released under the
+// Outbound license. Both are license names or SPDX identifiers.
+type compatibilityRule struct {
+	Outbound   string
+	Inbound    string
+	Compatible bool
+	Reason     string
+}
+
+// compatibility is the compatibility matrix, pairs that aren't in it are checked by license category.
+type compatibility []compatibilityRule
+
+// Conflict is a dependency license that is incompatible with the project license.
+type Conflict struct {
+	Module         string
+	Version        string
+	License        string
+	ProjectLicense string
+	Reason         string
+}
+
+// conflictsReport is the struct written to the conflictsFile.
+type conflictsReport struct {
+	ProjectLicense string
+	Detected       bool // False if the project license came from -project-license.
+	Conflicts      []Conflict
+}
+
+// initCompatibility creates the compatibility matrix using the data stored in CompatibilityJson.
+func initCompatibility(gopath string) (compatibility, error) {
+	return readCompatibility(configFile(gopath, "DES_COMPAT", compatibilityJson)) // Use the DES_COMPAT environment variable to change the path of the compatibility file.
+}
+
+// readCompatibility creates the compatibility matrix using the data stored in compatFile.
+func readCompatibility(compatFile string) (compatibility, error) {
+	compat := make(compatibility, 0)
+	err := initJsonConfigs(compatFile, &compat)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Println("No compatibility config files leveraged")
+			return make(compatibility, 0), nil
+		}
+		return nil, fmt.Errorf("error checking file: %w", err)
+	}
+	return compat, nil
+}
+
+// startProject makes the scanned repo the module currently being scanned, its licenses are the
+// outbound license of the project.
+func (s *Scanner) startProject(clone string) {
+	s.Project = &scannedModule{Name: s.cleanPath(clone, false)}
+	s.CurrentModule = s.Project
+}
+
+// finishProject sets the conclusion of the project and resets the per module state of the scanner.
+func (s *Scanner) finishProject() {
+	if s.Project != nil {
+		s.attachExceptions(s.Project)
+		s.Project.Expression = s.moduleExpression(s.Project)
+		s.Project.Conclusion = s.Project.conclusion()
+	}
+	s.Licensecanned = false
+	s.GitLicense = ""
+	s.CurrentModule = nil
+}
+
+// projectLicenses gets the outbound licenses of the project. The override is used if it is set,
+// it can be a license name, an SPDX identifier or an expression joined with AND or OR.
+func (s *Scanner) projectLicenses(override string) ([]string, bool) {
+	if override != "" {
+		var lics []string
+		for _, lic := range licenseOperator.Split(strings.Trim(override, "() "), -1) {
+			lics = appendUnique(lics, strings.Trim(lic, "() "))
+		}
+		return lics, false
+	}
+	if s.Project == nil {
+		return nil, true
+	}
+	var lics []string
+	alternatives, required := s.Project.splitLicenses()
+	for _, lic := range append(withoutCovered(required), alternatives...) {
+		if lic == unknownLicense || lic == noLicense {
+			continue
+		}
+		lics = append(lics, lic)
+	}
+	return lics, true
+}
+
+// find gets the rule for an outbound and inbound license, licenses are compared by their SPDX identifiers.
+func (c compatibility) find(s *Scanner, outbound, inbound string) (compatibilityRule, bool) {
+	out, in := s.spdxID(outbound), s.spdxID(inbound)
+	for _, rule := range c {
+		if s.spdxID(rule.Outbound) == out && s.spdxID(rule.Inbound) == in {
+			return rule, true
+		}
+	}
+	return compatibilityRule{}, false
+}
+
+// compatible checks if code under the inbound license can be used in a project under the outbound
+// license. The compatibility matrix is checked first, then the categories of both licenses. Copyleft
+// code is only compatible with a project under the same license or a pair the matrix allows, the
+// category of the project doesn't make a different copyleft license compatible. Licenses without a
+// category can't be checked and are left to the policy.
+func (s *Scanner) compatible(c compatibility, outbound, inbound string) (bool, string) {
+	if s.spdxID(outbound) == s.spdxID(inbound) {
+		return true, ""
+	}
+	rule, ok := c.find(s, outbound, inbound)
+	if ok {
+		return rule.Compatible, rule.Reason
+	}
+	outCategory, _ := licenseTerms(s.Licenses, s.Exceptions, outbound)
+	inCategory, _ := licenseTerms(s.Licenses, s.Exceptions, inbound)
+	switch inCategory {
+	case categoryStrongCopyleft:
+		return false, fmt.Sprintf("%s is strong copyleft, a project using it has to be released under the same license", inbound)
+	case categoryNetworkCopyleft:
+		return false, fmt.Sprintf("%s is network copyleft, a project using it has to be released under the same license even if it is only offered as a service", inbound)
+	case categoryNonCommercial:
+		return false, fmt.Sprintf("%s doesn't allow commercial use", inbound)
+	case categoryProprietary:
+		if outCategory == categoryProprietary {
+			return true, ""
+		}
+		return false, fmt.Sprintf("%s is proprietary, it can't be redistributed without an agreement with its owner", inbound)
+	}
+	return true, ""
+}
+
+// checkCompatibility checks the license of every module against the outbound license of the project
+// and writes the conflictsFile. A dual-licensed module only conflicts if none of its alternatives
+// is compatible.
+func (l *Launch) checkCompatibility() error {
+	if outbound, _ := l.Scanner.projectLicenses(l.ProjectLicense); len(outbound) == 0 {
+		log.Println("No project license found, skipping the compatibility check")
+		return nil
+	}
+	compat, err := initCompatibility(l.Gopath)
+	if err != nil {
+		return err
+	}
+	report, _ := l.Scanner.conflicts(compat, l.ProjectLicense)
+	return l.createReportFile(conflictsFile, func(w io.Writer) error {
+		return writeJson(w, report, "conflicts")
+	})
+}
+
+// conflicts checks the license of every module against the outbound license of the project, it is
+// false if there is no project license.
+func (s *Scanner) conflicts(compat compatibility, projectLicense string) (conflictsReport, bool) {
+	outbound, detected := s.projectLicenses(projectLicense)
+	if len(outbound) == 0 {
+		return conflictsReport{}, false
+	}
+	report := conflictsReport{ProjectLicense: projectLicense, Detected: detected}
+	if detected {
+		report.ProjectLicense = s.Project.Conclusion
+	}
+	for _, mod := range s.modulesInOrder() {
+		name, ver := splitModuleVersion(mod.Name)
+		alternatives, required := mod.splitLicenses()
+		for _, out := range outbound {
+			for _, in := range withoutCovered(required) {
+				ok, reason := s.compatible(compat, out, in)
+				if !ok {
+					report.Conflicts = append(report.Conflicts, Conflict{Module: name, Version: ver, License: in, ProjectLicense: out, Reason: reason})
+				}
+			}
+			if len(alternatives) == 0 {
+				continue
+			}
+			var reasons []string
+			for _, in := range alternatives {
+				ok, reason := s.compatible(compat, out, in)
+				if ok {
+					reasons = nil
+					break
+				}
+				reasons = append(reasons, reason)
+			}
+			if len(reasons) > 0 {
+				report.Conflicts = append(report.Conflicts, Conflict{Module: name, Version: ver, License: combineLicenses(alternatives, nil), ProjectLicense: out, Reason: strings.Join(reasons, "; ")})
+			}
+		}
+	}
+	for _, c := range report.Conflicts {
+		log.Printf("License conflict: %s@%s %s in a %s project: %s", c.Module, c.Version, c.License, c.ProjectLicense, c.Reason)
+	}
+	return report, true
+}
@@ -0,0 +1,128 @@
+package lic
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckCompatibility(t *testing.T) {
+	compat := filepath.Join(t.TempDir(), compatibilityJson)
+	bs, _ := json.Marshal(compatibility{{Outbound: "GPL-2.0-only", Inbound: "Apache-2.0", Reason: "patent terms"}})
+	os.WriteFile(compat, bs, os.ModePerm)
+	t.Setenv("DES_COMPAT", compat)
+
+	lics := licenses{
+		{Name: "MIT License", SPDX: "MIT", Category: categoryPermissive},
+		{Name: "Apache 2.0", SPDX: "Apache-2.0", Category: categoryPermissive},
+		{Name: "GPL v2", SPDX: "GPL-2.0-only", Category: categoryStrongCopyleft},
+	}
+	module := func(name string, files map[string]string) *scannedModule {
+		mod := &scannedModule{Name: name}
+		for file, lic := range files {
+			mod.Licenses = append(mod.Licenses, scannedLicense{License: lic, Info: LicenseInfo{Filename: filepath.Join(name, file)}})
+		}
+		return mod
+	}
+	dst := t.TempDir()
+	l := Launch{Dst: dst, Scanner: Scanner{
+		Licenses:  lics,
+		LicFolder: "compat_Licenses",
+		Project:   module("github.com/owner/project", map[string]string{"LICENSE": "GPL v2"}),
+		Modules: map[string]*scannedModule{
+			"example.com/apache@v1.0.0": module("example.com/apache@v1.0.0", map[string]string{"LICENSE": "Apache 2.0"}),
+			"example.com/mit@v1.0.0":    module("example.com/mit@v1.0.0", map[string]string{"LICENSE": "MIT License"}),
+			"example.com/dual@v1.0.0":   module("example.com/dual@v1.0.0", map[string]string{"LICENSE-APACHE": "Apache 2.0", "LICENSE-MIT": "MIT License"}),
+		},
+	}}
+	l.Scanner.Project.Conclusion = "GPL v2"
+	os.MkdirAll(filepath.Join(dst, l.Scanner.LicFolder), os.ModePerm)
+
+	readConflicts := func() conflictsReport {
+		report := conflictsReport{}
+		err := initJsonConfigs(filepath.Join(dst, l.Scanner.LicFolder, conflictsFile), &report)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+	err := l.checkCompatibility()
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := readConflicts()
+	if !report.Detected || report.ProjectLicense != "GPL v2" || len(report.Conflicts) != 1 {
+		t.Fatalf("Unexpected conflicts: %+v", report)
+	}
+	if c := report.Conflicts[0]; c.Module != "example.com/apache" || c.License != "Apache 2.0" || c.Reason != "patent terms" {
+		t.Fatalf("Unexpected conflict: %+v", c)
+	}
+
+	l.ProjectLicense = "MIT"
+	l.Scanner.Modules["example.com/gpl@v1.0.0"] = module("example.com/gpl@v1.0.0", map[string]string{"COPYING": "GPL v2"})
+	err = l.checkCompatibility()
+	if err != nil {
+		t.Fatal(err)
+	}
+	report = readConflicts()
+	if report.Detected || len(report.Conflicts) != 1 || report.Conflicts[0].Module != "example.com/gpl" {
+		t.Fatalf("Unexpected conflicts for the -project-license: %+v", report)
+	}
+}
+
+func TestCompatibleCopyleft(t *testing.T) {
+	s := &Scanner{Licenses: licenses{
+		{Name: "MIT License", SPDX: "MIT", Category: categoryPermissive},
+		{Name: "GPL v2", SPDX: "GPL-2.0-only", Category: categoryStrongCopyleft},
+		{Name: "GPL v3", SPDX: "GPL-3.0-only", Category: categoryStrongCopyleft},
+		{Name: "CC BY-SA 4.0", SPDX: "CC-BY-SA-4.0", Category: categoryStrongCopyleft},
+		{Name: "EUPL 1.1", SPDX: "EUPL-1.1", Category: categoryStrongCopyleft},
+		{Name: "AGPL v3", SPDX: "AGPL-3.0-only", Category: categoryNetworkCopyleft},
+		{Name: "SSPL", SPDX: "SSPL-1.0", Category: categoryNetworkCopyleft},
+	}}
+	compat := compatibility{{Outbound: "AGPL-3.0-only", Inbound: "GPL-3.0-only", Compatible: true}}
+	for _, tc := range []struct {
+		outbound, inbound string
+		want              bool
+	}{
+		{"GPL v2", "GPL-2.0-only", true},
+		{"GPL v2", "MIT License", true},
+		{"AGPL v3", "GPL v3", true},
+		{"GPL v2", "CC BY-SA 4.0", false},
+		{"GPL v2", "EUPL 1.1", false},
+		{"AGPL v3", "GPL v2", false},
+		{"GPL v3", "AGPL v3", false},
+		{"AGPL v3", "SSPL", false},
+		{"MIT License", "GPL v2", false},
+	} {
+		ok, reason := s.compatible(compat, tc.outbound, tc.inbound)
+		if ok != tc.want || (!ok && reason == "") {
+			t.Errorf("compatible(%s, %s) = %v %q, want %v", tc.outbound, tc.inbound, ok, reason, tc.want)
+		}
+	}
+}
+
+func TestShippedCompatibility(t *testing.T) {
+	compat, err := readCompatibility(filepath.Join("..", "..", "Config", compatibilityJson))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The embedded SPDX licenses, the GPL family is strong copyleft so only the matrix allows a pair.
+	s := &Scanner{Licenses: spdxDefinitions(nil)}
+	for _, tc := range []struct {
+		outbound, inbound string
+		want              bool
+	}{
+		{"AGPL-3.0-only", "GPL-3.0-only", true},
+		{"GPL-3.0-only", "LGPL-2.1-only", true},
+		{"GPL-3.0-only", "GPL-2.0-only", false},
+		{"GPL-2.0-only", "GPL-3.0-only", false},
+		{"GPL-3.0-only", "AGPL-3.0-only", false},
+	} {
+		ok, reason := s.compatible(compat, tc.outbound, tc.inbound)
+		if ok != tc.want || (!ok && reason == "") {
+			t.Errorf("compatible(%s, %s) = %v %q, want %v", tc.outbound, tc.inbound, ok, reason, tc.want)
+		}
+	}
+}
@@ -4,8 +4,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 )
 
+// configFile gets the path of a config file, the environment variable env overrides the file of the
+// same name in the Config folder of lic-col in GOPATH/src.
+func configFile(gopath, env, name string) string {
+	path, ok := os.LookupEnv(env)
+	if !ok {
+		path = filepath.Join(gopath, "src", "github.com", "JCPrice0024", "lic-col", "Config", name)
+	}
+	return path
+}
+
 // initJsonConfigs decodes filename into the interface i. This makes it easier to
 // get the required information from the Config files.
 func initJsonConfigs(filename string, i interface{}) error {
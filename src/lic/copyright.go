@@ -0,0 +1,132 @@
+package lic
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// noticeFile is the key for NOTICE files. They aren't classified, they are only copied and
+// scanned for copyright notices.
+const noticeFile = "Notice"
+
+// attributionFile is the file in the LicFolder that lists the copyright holders of every module.
+const attributionFile = "attribution.txt"
+
+var (
+	// copyrightLine matches a copyright statement, it must be followed by (c), © or a year so prose
+	// like "the above copyright notice" isn't matched.
+	copyrightLine = regexp.MustCompile(`(?i)^copyright\s*(\(c\)|©|&copy;|\d{4})`)
+	// copyrightPlaceholder matches the placeholders used in license templates.
+	copyrightPlaceholder = regexp.MustCompile(`(?i)[\[{<]\s*(yyyy|year|name of copyright owner|fullname|copyright holders?)\s*[\]}>]`)
+	// copyrightPrefix matches everything before the holder of a copyright statement.
+	copyrightPrefix = regexp.MustCompile(`(?i)^copyright\s*((\(c\)|©|&copy;)\s*)?(\d{4}(\s*[-–,]\s*(\d{4}|present))*\s*,?\s*)*(by\s+)?`)
+	// rightsReserved matches the end of a copyright statement.
+	rightsReserved = regexp.MustCompile(`(?i)[.,;]?\s*all rights reserved\.?$`)
+)
+
+// isNoticeFile is a simple regex used to determine if a filename is a NOTICE file.
+func isNoticeFile(name string) bool {
+	notice := regexp.MustCompile(`(?i)^notice(\.[a-z]+)?$`)
+	return notice.MatchString(name)
+}
+
+// extractCopyrights gets every copyright statement in a license or NOTICE file.
+func extractCopyrights(bs []byte) []string {
+	var copyrights []string
+	sc := bufio.NewScanner(bytes.NewReader(bs))
+	for sc.Scan() {
+		line := strings.TrimSpace(strings.Trim(strings.TrimSpace(sc.Text()), "/*#;-"))
+		if !copyrightLine.MatchString(line) || copyrightPlaceholder.MatchString(line) {
+			continue
+		}
+		line = strings.Join(strings.Fields(line), " ")
+		if copyrightHolder(line) == "" {
+			continue
+		}
+		copyrights = appendUnique(copyrights, line)
+	}
+	return copyrights
+}
+
+// copyrightHolder gets the holder of a copyright statement
+// (example: "Copyright (c) 2009-2012 The Go Authors. All rights reserved." becomes "The Go Authors").
+func copyrightHolder(statement string) string {
+	holder := copyrightPrefix.ReplaceAllString(statement, "")
+	holder = rightsReserved.ReplaceAllString(holder, "")
+	return strings.TrimSpace(strings.TrimRight(holder, " .,;"))
+}
+
+// scanNotice copies a NOTICE file and records its copyright statements under the noticeFile key.
+func (s *Scanner) scanNotice(path string, bs []byte) error {
+	licInfo := LicenseInfo{Filename: s.cleanPath(path, false),
+		Filepath:   s.copyName(bs),
+		SHA256:     licenseHash(bs),
+		GitLink:    s.link(path),
+		GitLicense: s.GitLicense,
+		Copyrights: extractCopyrights(bs)}
+	s.Exclusions[path] = struct{}{}
+	s.addLicense(noticeFile, licInfo)
+	return s.copyLicense(path, bs)
+}
+
+// createAttributionFile writes the copyright holders of every module into the attributionFile.
+func createAttributionFile(scanner Scanner) error {
+	return scanner.createReportFile(attributionFile, func(w io.Writer) error {
+		return writeAttribution(w, scanner)
+	})
+}
+
+// writeAttribution writes the copyright holders of every module and the shared copies of its license
+// texts, a text used by several modules is referenced by each of them.
+func writeAttribution(w io.Writer, scanner Scanner) error {
+	holders := make(map[string][]string)
+	copies := make(map[string][]string)
+	for _, infos := range scanner.LicenseType {
+		for _, info := range infos {
+			name := moduleName(info.Filename)
+			for _, c := range info.Copyrights {
+				holders[name] = appendUnique(holders[name], copyrightHolder(c))
+			}
+			if info.SHA256 != "" {
+				copies[name] = appendUnique(copies[name], info.SHA256)
+			}
+		}
+	}
+	texts := licenseTexts(scanner.LicenseType)
+	mods := make([]string, 0, len(holders))
+	for mod := range holders {
+		mods = append(mods, mod)
+	}
+	for mod := range copies {
+		if _, ok := holders[mod]; !ok {
+			mods = append(mods, mod)
+		}
+	}
+	sort.Slice(mods, func(i, j int) bool { return lessModuleName(mods[i], mods[j]) })
+	buf := new(bytes.Buffer)
+	for _, mod := range mods {
+		sort.Strings(holders[mod])
+		fmt.Fprintln(buf, mod)
+		for _, h := range holders[mod] {
+			fmt.Fprintf(buf, "    %s\n", h)
+		}
+		sort.Slice(copies[mod], func(i, j int) bool {
+			return texts[copies[mod][i]].Filepath < texts[copies[mod][j]].Filepath
+		})
+		for _, hash := range copies[mod] {
+			text := texts[hash]
+			if len(text.Modules) > 1 {
+				fmt.Fprintf(buf, "    Text: %s (shared by %d modules)\n", text.Filepath, len(text.Modules))
+			} else {
+				fmt.Fprintf(buf, "    Text: %s\n", text.Filepath)
+			}
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
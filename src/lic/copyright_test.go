@@ -0,0 +1,70 @@
+package lic
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestExtractCopyrights(t *testing.T) {
+	text := `Copyright (c) 2009-2012 The Go Authors. All rights reserved.
+
+ * Copyright 2015, 2017 by Jane Doe
+Copyright [yyyy] [name of copyright owner]
+The above copyright notice and this permission notice shall be included.
+Copyright © 2020 Example, Inc.
+Copyright (c) 2009-2012 The Go Authors. All rights reserved.`
+	got := extractCopyrights([]byte(text))
+	want := []string{
+		"Copyright (c) 2009-2012 The Go Authors. All rights reserved.",
+		"Copyright 2015, 2017 by Jane Doe",
+		"Copyright © 2020 Example, Inc.",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Unexpected copyrights: %q", got)
+	}
+	holders := []string{}
+	for _, c := range got {
+		holders = append(holders, copyrightHolder(c))
+	}
+	if !reflect.DeepEqual(holders, []string{"The Go Authors", "Jane Doe", "Example, Inc"}) {
+		t.Fatalf("Unexpected holders: %q", holders)
+	}
+}
+
+func TestNoticeAttribution(t *testing.T) {
+	mit := "Copyright 2021 Jane Doe\nPermission is hereby granted"
+	notice := "This product includes software developed by\nCopyright (c) 2019 The Apache Software Foundation"
+	proxy := newTestProxy(t)
+	proxy.add("example.com/notice", "v1.0.0", map[string]string{"LICENSE": mit, "NOTICE": notice})
+	proxy.add("example.com/other", "v1.0.0", map[string]string{"LICENSE": mit})
+	dst := t.TempDir()
+	scan := newProxyScanner(t, proxy, dst, "notice")
+	scan.Licenses = licenses{{Name: "MIT License", SPDX: "MIT", Lines: []string{DefinitionFormat("Permission is hereby granted")}}}
+	err := scan.ScanPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scan.LicenseType[noticeFile]) != 1 || len(scan.LicenseType["MIT License"]) != 2 {
+		t.Fatalf("Expected a NOTICE and a license: %v", scan.LicenseType)
+	}
+	if expr := scan.Modules["example.com/notice@v1.0.0"].Expression; expr != "MIT" {
+		t.Fatalf("NOTICE should not be part of the expression: %s", expr)
+	}
+	bs, err := os.ReadFile(filepath.Join(dst, scan.LicFolder, attributionFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Both modules reference the one copy of the MIT text they share.
+	mitText := "    Text: Licenses/" + licenseHash([]byte(mit)) + " (shared by 2 modules)\n"
+	texts := []string{mitText, "    Text: Licenses/" + licenseHash([]byte(notice)) + "\n"}
+	sort.Strings(texts)
+	want := "example.com/notice@v1.0.0\n    Jane Doe\n    The Apache Software Foundation\n" + strings.Join(texts, "") +
+		"example.com/other@v1.0.0\n    Jane Doe\n" + mitText
+	if string(bs) != want {
+		t.Fatalf("Unexpected attribution file:\n%s", bs)
+	}
+}
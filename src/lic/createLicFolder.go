@@ -1,13 +1,18 @@
 package lic
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 )
 
 // licTypesFile is the json file where a list of all known files and the
@@ -16,40 +21,133 @@ const licTypesFile = "licensetypes.json"
 
 // createLicTypesFile simply creates the LicTypesFile.
 func createLicTypesFile(scanner Scanner) error {
-	_, err := os.Stat(scanner.DstPath)
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return fmt.Errorf("licfolder was never made license scan failed: %w", err)
+	if scanner.Output == nil {
+		_, err := os.Stat(scanner.DstPath)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("licfolder was never made license scan failed: %w", err)
+			}
+			return err
 		}
-		return err
 	}
-	bs, err := json.MarshalIndent(scanner.LicenseType, "", "   ")
-	if err != nil {
-		return fmt.Errorf("error marshaling licenseTypes: %w", err)
+	return scanner.createReportFile(licTypesFile, func(w io.Writer) error {
+		return writeJson(w, scanner.LicenseType, "licenseTypes")
+	})
+}
+
+// textsFile is the json file in the LicFolder that lists every copied license text by its SHA-256.
+const textsFile = "licensetexts.json"
+
+// licenseText is a single entry of the textsFile.
+type licenseText struct {
+	Filepath string   // Path of the copy in the LicFolder.
+	Licenses []string // Licenses the text was found as.
+	Modules  []string // Every module with a file holding the text.
+	Files    int      // Number of files holding the text.
+}
+
+// licenseTexts groups the files of the LicenseType by the SHA-256 of their text.
+func licenseTexts(lt map[string][]LicenseInfo) map[string]*licenseText {
+	texts := make(map[string]*licenseText)
+	for lic, infos := range lt {
+		for _, info := range infos {
+			if info.SHA256 == "" {
+				continue
+			}
+			text, ok := texts[info.SHA256]
+			if !ok {
+				text = &licenseText{Filepath: info.Filepath}
+				texts[info.SHA256] = text
+			}
+			text.Licenses = appendUnique(text.Licenses, lic)
+			text.Modules = appendUnique(text.Modules, moduleName(info.Filename))
+			text.Files++
+		}
+	}
+	for _, text := range texts {
+		sort.Strings(text.Licenses)
+		sort.Slice(text.Modules, func(i, j int) bool { return lessModuleName(text.Modules[i], text.Modules[j]) })
 	}
+	return texts
+}
+
+// createLicenseTextsFile writes every copied license text and the modules using it into the textsFile.
+func createLicenseTextsFile(scanner Scanner) error {
+	return scanner.createReportFile(textsFile, func(w io.Writer) error {
+		return writeJson(w, licenseTexts(scanner.LicenseType), "license texts")
+	})
+}
 
-	return os.WriteFile(filepath.Join(scanner.DstPath, scanner.LicFolder, licTypesFile), bs, os.ModePerm)
+// sortLicenseTypes sorts the files of every license by module, version and path so the reports don't
+// depend on the order the modules were scanned in.
+func sortLicenseTypes(lt map[string][]LicenseInfo) {
+	for _, infos := range lt {
+		sort.SliceStable(infos, func(i, j int) bool {
+			if infos[i].Filename != infos[j].Filename {
+				return lessModuleName(infos[i].Filename, infos[j].Filename)
+			}
+			return infos[i].Filepath < infos[j].Filepath
+		})
+	}
 }
 
-// createLicFolder copies all License files into a Licenses folder found in the LicFolder.
-func (s *Scanner) createLicFolder(licPath string, data []byte) error {
-	licFolder := filepath.Join(s.DstPath, s.LicFolder, "Licenses")
-	err := os.MkdirAll(licFolder, os.ModePerm)
+// writeJson writes v as indented json, what names v in the error.
+func writeJson(w io.Writer, v interface{}, what string) error {
+	bs, err := json.MarshalIndent(v, "", "   ")
 	if err != nil {
-		return fmt.Errorf("error making license folder directory: %w", err)
+		return fmt.Errorf("error marshaling %s: %w", what, err)
 	}
-	licNameExt := licPathCleanup(filepath.Dir(licPath), true)
+	_, err = w.Write(bs)
+	return err
+}
 
-	dstFileName := filepath.Base(licPath) + licNameExt
+// metadataFile is the json file in the LicFolder that holds information about what was scanned.
+const metadataFile = "metadata.json"
 
-	dstFile := filepath.Join(licFolder, dstFileName)
-	dFile, err := os.OpenFile(dstFile, os.O_RDWR|os.O_CREATE, 0666)
-	if err != nil {
-		return fmt.Errorf("error with dst file directory: %w", err)
+// scanMetadata is the struct written to the metadataFile.
+type scanMetadata struct {
+	Repo      string
+	Ref       string
+	Commit    string
+	Partial   bool   `json:",omitempty"` // True if the scan was interrupted before every module was scanned.
+	Generated string `json:",omitempty"` // Time the report was written, only set with -timestamp.
+}
+
+// createMetadataFile creates the metadataFile, it records the repo, the requested ref and the commit
+// hash that ref resolved to. The time of the scan is only recorded if Timestamp is set so reports of
+// the same commit are identical.
+func (l *Launch) createMetadataFile() error {
+	meta := scanMetadata{Repo: l.Repo, Ref: l.Ref, Commit: l.Commit, Partial: l.Partial}
+	if l.Timestamp {
+		meta.Generated = time.Now().UTC().Format(time.RFC3339)
+	}
+	return l.createReportFile(metadataFile, func(w io.Writer) error {
+		return writeJson(w, meta, "metadata")
+	})
+}
+
+// licensesFolder is the folder of the LicFolder that holds the copies of the license files.
+const licensesFolder = "Licenses"
+
+// licenseHash gets the SHA-256 of a license text as hex, copies are stored under it.
+func licenseHash(bs []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(bs))
+}
+
+// copyName gets the name of the copy of a license text relative to the LicFolder. Copies are content
+// addressed (example: Licenses/<sha256 of the text>), every file holding the same text shares one
+// copy. Copies made with -tohtml end in .html.
+func (s *Scanner) copyName(bs []byte) string {
+	name := path.Join(licensesFolder, licenseHash(bs))
+	if s.ToHTML {
+		name += ".html"
 	}
-	defer dFile.Close()
+	return name
+}
 
-	_, err = dFile.Write(data)
+// createLicFolder copies a license text into the Licenses folder found in the LicFolder.
+func (s *Scanner) createLicFolder(data []byte) error {
+	err := s.output().WriteFile(path.Join(filepath.ToSlash(s.LicFolder), s.copyName(data)), data)
 	if err != nil {
 		return fmt.Errorf("error copying license file: %w", err)
 	}
@@ -0,0 +1,110 @@
+package lic
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// exceptionsJson is the name of the json file that holds all known license exceptions.
+const exceptionsJson = "definedexceptions.json"
+
+// withOperator joins a license and an exception to it, the same way as in an SPDX license expression.
+const withOperator = " WITH "
+
+// initExceptions creates the exception catalogue using the data stored in ExceptionsJson. Exceptions
+// use the same struct as the defined licenses, the SPDX field holds the SPDX exception id.
+func initExceptions(gopath string) (licenses, error) {
+	return readExceptions(configFile(gopath, "DES_EXCEPT", exceptionsJson)) // Use the DES_EXCEPT environment variable to change the path of the exceptions file.
+}
+
+// readExceptions creates the exception catalogue using the data stored in exceptionsFile.
+func readExceptions(exceptionsFile string) (licenses, error) {
+	excs := make(licenses, 0)
+	err := initJsonConfigs(exceptionsFile, &excs)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Println("No exception config files leveraged")
+			return make(licenses, 0), nil
+		}
+		return nil, fmt.Errorf("error checking file: %w", err)
+	}
+	for excIndex, def := range excs {
+		checkTerms(def)
+		for lineIndex, line := range def.Lines {
+			excs[excIndex].Lines[lineIndex] = DefinitionFormat(line)
+		}
+	}
+	return excs, nil
+}
+
+// checkException gets the name of the exception found in a formatted license, it returns an empty
+// string if there is none.
+func (s *Scanner) checkException(licDef string) string {
+	for _, exc := range s.Exceptions {
+		if TestLicense(licDef, exc, false) {
+			return exc.Name
+		}
+	}
+	return ""
+}
+
+// spdxID is licenses.spdxID for license names that may have an exception (example: "GPL v2 WITH
+// Classpath Exception 2.0" becomes "GPL-2.0-only WITH Classpath-exception-2.0").
+func (s *Scanner) spdxID(name string) string {
+	name = strings.TrimSuffix(name, overrideSuffix)
+	lic, exc, ok := strings.Cut(name, withOperator)
+	if !ok {
+		return s.Licenses.spdxID(name)
+	}
+	return s.Licenses.spdxID(lic) + withOperator + s.Exceptions.spdxID(exc)
+}
+
+// isException is true if the name is the name of a defined exception.
+func (s *Scanner) isException(name string) bool {
+	for _, exc := range s.Exceptions {
+		if exc.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// attachExceptions attaches the exceptions found in files of their own (example: a LICENSE.exception
+// next to the LICENSE) to the license of the module. This is only done if the module has a single
+// license, otherwise the exception is left under its own name.
+func (s *Scanner) attachExceptions(mod *scannedModule) {
+	var bases []string
+	for _, l := range mod.Licenses {
+		if l.License == unknownLicense || l.License == noLicense || l.License == noticeFile || s.isException(l.License) {
+			continue
+		}
+		base, _, _ := strings.Cut(strings.TrimSuffix(l.License, overrideSuffix), withOperator)
+		bases = appendUnique(bases, base)
+	}
+	if len(bases) != 1 {
+		return
+	}
+	for i, l := range mod.Licenses {
+		if !s.isException(l.License) {
+			continue
+		}
+		lic := bases[0] + withOperator + l.License
+		infos := s.LicenseType[l.License]
+		for j, info := range infos {
+			if info.Filename == l.Info.Filename {
+				infos = append(infos[:j], infos[j+1:]...)
+				break
+			}
+		}
+		if len(infos) == 0 {
+			delete(s.LicenseType, l.License)
+		} else {
+			s.LicenseType[l.License] = infos
+		}
+		s.LicenseType[lic] = append(s.LicenseType[lic], l.Info)
+		mod.Licenses[i].License = lic
+	}
+}
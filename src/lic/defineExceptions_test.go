@@ -0,0 +1,47 @@
+package lic
+
+import (
+	"testing"
+)
+
+func TestLicenseExceptions(t *testing.T) {
+	proxy := newTestProxy(t)
+	gpl := "GNU General Public License version 2"
+	classpath := "As a special exception, the copyright holders of this library give you permission to link this library with independent modules"
+	proxy.add("example.com/samefile", "v1.0.0", map[string]string{
+		"LICENSE": gpl + "\n\n" + classpath,
+	})
+	proxy.add("example.com/ownfile", "v1.0.0", map[string]string{
+		"LICENSE":           gpl,
+		"LICENSE.exception": classpath,
+	})
+	proxy.add("example.com/plain", "v1.0.0", map[string]string{
+		"LICENSE": gpl,
+	})
+	scan := newProxyScanner(t, proxy, t.TempDir(), "exceptions")
+	scan.Licenses = licenses{{Name: "GPL v2", SPDX: "GPL-2.0-only", Lines: []string{DefinitionFormat(gpl)}}}
+	scan.Exceptions = licenses{{Name: "Classpath Exception 2.0", SPDX: "Classpath-exception-2.0", Lines: []string{DefinitionFormat(classpath)}}}
+	scan.Policy = policy{Deny: []string{"GPL v2"}}
+	err := scan.ScanPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	withExc := "GPL v2 WITH Classpath Exception 2.0"
+	if len(scan.LicenseType[withExc]) != 2 || len(scan.LicenseType["GPL v2"]) != 2 {
+		t.Fatalf("Unexpected license types: %v", scan.LicenseType)
+	}
+	if _, ok := scan.LicenseType["Classpath Exception 2.0"]; ok {
+		t.Fatalf("Exception should be attached to the license: %v", scan.LicenseType)
+	}
+	for _, r := range scan.moduleResults() {
+		want := withExc
+		wantExpr := "GPL-2.0-only WITH Classpath-exception-2.0"
+		wantStatus := statusAllowed
+		if r.Module == "example.com/plain" {
+			want, wantExpr, wantStatus = "GPL v2", "GPL-2.0-only", statusDenied
+		}
+		if r.Conclusion != want || r.Expression != wantExpr || r.Status != wantStatus {
+			t.Errorf("Unexpected result for %s: %+v", r.Module, r)
+		}
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"path/filepath"
 )
 
 // exclusions is a map that holds license filenames that are to be ignored.
@@ -22,11 +21,12 @@ const excludedEXTJson = "excludedextensions.json"
 
 // InitExlusions creates an Exclusions map using the data stored in ExcludedJson.
 func initExclusions(gopath string) (exclusions, error) {
+	return readExclusions(configFile(gopath, "DES_EXCL", exclusionsJson)) // Use the DES_EXCL environment variable to change the path of the Exclusions file.
+}
+
+// readExclusions creates an Exclusions map using the data stored in excludedFile.
+func readExclusions(excludedFile string) (exclusions, error) {
 	var nilMap exclusions
-	excludedFile, ok := os.LookupEnv("DES_EXCL") // Use the DES_EXCL environment variable to change the path of the Exclusions file.
-	if !ok {
-		excludedFile = filepath.Join(gopath, "src", "github.com", "JCPrice0024", "lic-col", "Config", exclusionsJson)
-	}
 	excl := make(exclusions)
 	err := initJsonConfigs(excludedFile, &excl)
 	if err != nil {
@@ -41,11 +41,12 @@ func initExclusions(gopath string) (exclusions, error) {
 
 // initExcludedEXT creates an ExcludedEXT map using the info stored in the ExcludedEXT file.
 func initExcludedEXT(gopath string) (excludedEXT, error) {
+	return readExcludedEXT(configFile(gopath, "DES_EXT", excludedEXTJson)) // Use the DES_EXT environment variable to change the path of the ExludedEXT file.
+}
+
+// readExcludedEXT creates an ExcludedEXT map using the info stored in excludedFile.
+func readExcludedEXT(excludedFile string) (excludedEXT, error) {
 	var nilMap excludedEXT
-	excludedFile, ok := os.LookupEnv("DES_EXT") // Use the DES_EXT environment variable to change the path of the ExludedEXT file.
-	if !ok {
-		excludedFile = filepath.Join(gopath, "src", "github.com", "JCPrice0024", "lic-col", "Config", excludedEXTJson)
-	}
 	ext := make(excludedEXT)
 	err := initJsonConfigs(excludedFile, &ext)
 	if err != nil {
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"path/filepath"
 )
 
 // inclusions is a map that holds non-license filenames that are to be included.
@@ -29,11 +28,12 @@ const overrideJson = "overridelicense.json"
 
 // initInclusions creates an Inclusions map using the data stored in IncludedJson.
 func initInclusions(gopath string) (inclusions, error) {
+	return readInclusions(configFile(gopath, "DES_INCL", inclusionsJson))
+}
+
+// readInclusions creates an Inclusions map using the data stored in includedFile.
+func readInclusions(includedFile string) (inclusions, error) {
 	var nilMap inclusions
-	includedFile, ok := os.LookupEnv("DES_INCL")
-	if !ok {
-		includedFile = filepath.Join(gopath, "src", "github.com", "JCPrice0024", "lic-col", "Config", inclusionsJson)
-	}
 	incl := make(inclusions)
 	err := initJsonConfigs(includedFile, &incl)
 	if err != nil {
@@ -48,11 +48,12 @@ func initInclusions(gopath string) (inclusions, error) {
 
 // initOverrides creates an Overrides map using the data stored in OverrideJson.
 func initOverrides(gopath string) (overrides, error) {
+	return readOverrides(configFile(gopath, "DES_OVER", overrideJson))
+}
+
+// readOverrides creates an Overrides map using the data stored in includedFile.
+func readOverrides(includedFile string) (overrides, error) {
 	var nilMap overrides
-	includedFile, ok := os.LookupEnv("DES_OVER")
-	if !ok {
-		includedFile = filepath.Join(gopath, "src", "github.com", "JCPrice0024", "lic-col", "Config", overrideJson)
-	}
 	ovr := make(overrides)
 	err := initJsonConfigs(includedFile, &ovr)
 	if err != nil {
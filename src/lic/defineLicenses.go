@@ -8,12 +8,20 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/JCPrice0024/lic-col/src/lic/spdxlicenses"
 )
 
 // definedLicense is the struct used to hold defined licenses.
 type definedLicense struct {
-	Name  string
-	Lines []string
+	Name        string
+	SPDX        string   `json:",omitempty"` // SPDX identifier of the license, used in license expressions.
+	Category    string   `json:",omitempty"` // example: permissive, strong-copyleft.
+	Obligations []string `json:",omitempty"` // example: attribution, disclose-source.
+	Lines       []string
+
+	rawLines []string              // Lines as they were written in DefinedJson, used to explain matches.
+	template *spdxlicenses.Matcher // Set for the licenses of the embedded SPDX license list, they have no Lines.
 }
 
 // licenses is a map that is used to check known licenses in filewalk.
@@ -22,34 +30,71 @@ type licenses []definedLicense
 // definedJson is the name of the json file that holds all known licenses.
 const definedJson = "definedlicenses.json"
 
-// InitLicense creates a Licenses map using the data stored in DefinedJson.
+// InitLicense creates a Licenses map using the data stored in DefinedJson followed by the embedded SPDX
+// license list. The definitions of DefinedJson are an overlay, they are checked first and replace the
+// SPDX licenses with the same SPDX identifier.
 func InitLicense(gopath string) (licenses, error) {
+	return readLicenses(definedLicensesPath(gopath))
+}
+
+// readLicenses creates the defined licenses using the data stored in definedFile and the embedded
+// SPDX license list.
+func readLicenses(definedFile string) (licenses, error) {
 	var nilMap licenses
-	definedFile, ok := os.LookupEnv("DES_LIC")
-	if !ok {
-		definedFile = filepath.Join(gopath, "src", "github.com", "JCPrice0024", "lic-col", "Config", definedJson)
-	}
 	lics := make(licenses, 0)
 	err := initJsonConfigs(definedFile, &lics)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			log.Println("No license config files leveraged")
-			return make(licenses, 0), nil
+			return spdxDefinitions(nil), nil
 		}
 		return nilMap, fmt.Errorf("error checking file: %w", err)
 	}
 	for licIndex, def := range lics {
+		checkTerms(def)
+		lics[licIndex].rawLines = append([]string{}, def.Lines...)
 		for lineIndex, line := range def.Lines {
 			lics[licIndex].Lines[lineIndex] = DefinitionFormat(line)
 		}
 	}
-	return lics, nil
+	return append(lics, spdxDefinitions(lics)...), nil
+}
+
+// spdxDefinitions creates the definitions of the embedded SPDX licenses that the overlay doesn't define.
+func spdxDefinitions(overlay licenses) licenses {
+	lics := make(licenses, 0)
+	for _, l := range spdxlicenses.List() {
+		if _, ok := overlay.find(l.ID); ok {
+			continue
+		}
+		template, err := spdxlicenses.Compile(l.Template)
+		if err != nil {
+			log.Printf("Unable to use SPDX license %s: %v", l.ID, err)
+			continue
+		}
+		terms := spdxTerms[l.ID]
+		lics = append(lics, definedLicense{Name: l.Name, SPDX: l.ID, Category: terms.Category, Obligations: terms.Obligations, template: template})
+	}
+	return lics
+}
+
+// withoutTemplates removes the embedded SPDX licenses, only the definitions of DefinedJson are left.
+func (l licenses) withoutTemplates() licenses {
+	lics := make(licenses, 0, len(l))
+	for _, def := range l {
+		if def.template == nil {
+			lics = append(lics, def)
+		}
+	}
+	return lics
 }
 
-// isLicenseFile is a simple regex used to determine if a filename is a license file or not.
+// isLicenseFile is a simple regex used to determine if a filename is a license file or not. COPYING
+// files are license files too, but only the filename is checked for them.
 func isLicenseFile(path string) bool {
-	licenseFile := regexp.MustCompile(`(?i)(.*)license(.*)`)
-	return licenseFile.MatchString(path)
+	licenseFile := regexp.MustCompile(`(?i)(.*)licen[cs]e(.*)`)
+	copying := regexp.MustCompile(`(?i)^copying`)
+	return licenseFile.MatchString(path) || copying.MatchString(filepath.Base(path))
 }
 
 // DefinitionFormat is a simple regex used to format license definitions for comparison.
@@ -0,0 +1,102 @@
+package lic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testZlib = `zlib License
+
+Copyright (c) 2021 Example Authors
+
+This software is provided 'as-is', without any express or implied
+warranty. In no event will the authors be held liable for any damages
+arising from the use of this software.
+
+Permission is granted to anyone to use this software for any purpose,
+including commercial applications, and to alter it and redistribute it
+freely, subject to the following restrictions:
+
+1. The origin of this software must not be misrepresented; you must not
+   claim that you wrote the original software. If you use this software
+   in a product, an acknowledgment in the product documentation would be
+   appreciated but is not required.
+2. Altered source versions must be plainly marked as such, and must not be
+   misrepresented as being the original software.
+3. This notice may not be removed or altered from any source distribution.
+`
+
+const testMIT = `MIT License
+
+Copyright (c) 2022 Example Authors
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+`
+
+func TestSPDXLicenseList(t *testing.T) {
+	overlay := filepath.Join(t.TempDir(), definedJson)
+	err := os.WriteFile(overlay, []byte(`[{"Name": "Company MIT", "SPDX": "MIT", "Category": "permissive", "Lines": ["Permission is hereby granted, free of charge"]}]`), os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("DES_LIC", overlay)
+	proxy := newTestProxy(t)
+	proxy.add("example.com/zlib", "v1.0.0", map[string]string{"LICENSE": testZlib})
+	proxy.add("example.com/mit", "v1.0.0", map[string]string{"LICENSE": testMIT})
+
+	for _, noSPDX := range []bool{false, true} {
+		scan := newProxyScanner(t, proxy, t.TempDir(), "spdx")
+		if noSPDX {
+			scan.Licenses = scan.Licenses.withoutTemplates()
+		}
+		if def, ok := scan.Licenses.find("MIT"); !ok || def.Name != "Company MIT" {
+			t.Fatalf("The overlay should replace the SPDX MIT license: %+v", def)
+		}
+		err := scan.ScanPath()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(scan.LicenseType["Company MIT"]) != 1 {
+			t.Errorf("The overlay should match MIT first: %v", scan.LicenseType)
+		}
+		zlibType := "zlib License"
+		if noSPDX {
+			zlibType = unknownLicense
+		}
+		if len(scan.LicenseType[zlibType]) != 1 {
+			t.Errorf("Zlib should be %s: %v", zlibType, scan.LicenseType)
+		}
+		if !noSPDX && scan.spdxID(zlibType) != "Zlib" {
+			t.Errorf("Unexpected SPDX id for zlib: %s", scan.spdxID(zlibType))
+		}
+	}
+}
+
+func TestSPDXTerms(t *testing.T) {
+	lics := spdxDefinitions(nil)
+	for _, def := range lics {
+		if def.Category == "" {
+			t.Errorf("The embedded license %s has no category in spdxTerms", def.SPDX)
+		}
+	}
+	if def, ok := lics.find("EUPL-1.2"); !ok || def.Category != categoryStrongCopyleft {
+		t.Errorf("Expected the current EUPL to be embedded as strong copyleft: %+v", def)
+	}
+}
@@ -0,0 +1,185 @@
+package lic
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/JCPrice0024/lic-col/src/lic/spdxlicenses"
+)
+
+// The limits of the lines picked for a new definition, in words.
+const (
+	minDefinitionWords = 5
+	maxDefinitionWords = 20
+)
+
+var (
+	// sentenceEnd splits a paragraph of a license into sentences and clauses.
+	sentenceEnd = regexp.MustCompile(`[.;:!?]\s+|[.;:!?]$`)
+	// commentMarker matches the comment markers at the start of a line of a license header.
+	commentMarker = regexp.MustCompile(`(?m)^[ \t]*(//|#|/\*+|\*/|\*)`)
+	// paragraphBreak matches the blank lines between paragraphs.
+	paragraphBreak = regexp.MustCompile(`\n\s*\n`)
+)
+
+// definedLicensesPath gets the path of the DefinedJson file, DES_LIC overrides it.
+func definedLicensesPath(gopath string) string {
+	return configFile(gopath, "DES_LIC", definedJson)
+}
+
+// AddDefinition creates a definition for the sample license text and appends it to the DefinedJson file.
+// Up to count lines are picked from the sample so that no other license contains them, the definition
+// is refused if there are no such lines or if the sample is already classified by an existing definition.
+func AddDefinition(gopath string, sample []byte, name, spdx, category string, count int) (definedLicense, string, error) {
+	path := definedLicensesPath(gopath)
+	existing := make(licenses, 0)
+	err := initJsonConfigs(path, &existing)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return definedLicense{}, path, fmt.Errorf("error checking file: %w", err)
+	}
+	def, err := newDefinition(sample, name, spdx, existing, count)
+	if err != nil {
+		return definedLicense{}, path, err
+	}
+	if category != "" {
+		def.Category = category
+	}
+	checkTerms(def)
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "   ")
+	err = enc.Encode(append(existing, def))
+	if err != nil {
+		return definedLicense{}, path, fmt.Errorf("error marshaling definitions: %w", err)
+	}
+	err = os.WriteFile(path, buf.Bytes(), os.ModePerm)
+	if err != nil {
+		return definedLicense{}, path, fmt.Errorf("error writing definitions: %w", err)
+	}
+	return def, path, nil
+}
+
+// newDefinition creates a definition for the sample license text. The lines of existing are the raw
+// lines of DefinedJson, the embedded SPDX licenses are used as the texts of the other licenses. A line
+// is picked if no run of minDefinitionWords of its words is in another license.
+func newDefinition(sample []byte, name, spdx string, existing licenses, count int) (definedLicense, error) {
+	if name == "" {
+		return definedLicense{}, errors.New("error creating definition: no name")
+	}
+	for _, def := range existing {
+		if def.Name == name || (spdx != "" && def.SPDX == spdx) {
+			return definedLicense{}, fmt.Errorf("error creating definition: %s is already defined as %s", name, def.Name)
+		}
+	}
+	sampleDef := DefinitionFormat(string(sample))
+	for _, def := range existing {
+		formatted := def
+		formatted.Lines = make([]string, len(def.Lines))
+		for i, line := range def.Lines {
+			formatted.Lines[i] = DefinitionFormat(line)
+		}
+		if len(formatted.Lines) > 0 && TestLicense(sampleDef, formatted, false) {
+			return definedLicense{}, fmt.Errorf("error creating definition: the sample is already classified as %s, its lines are all in the sample", def.Name)
+		}
+	}
+
+	// A line that no other license contains can't classify the files of another license, so the new
+	// definition doesn't shadow any of them.
+	others := otherLicenseShingles(spdx)
+	var picked []string
+	for _, line := range candidateLines(string(sample)) {
+		formatted := DefinitionFormat(line)
+		distinct := true
+		words := spdxlicenses.Words(line)
+		for i := 0; i+minDefinitionWords <= len(words); i++ {
+			if others[strings.Join(words[i:i+minDefinitionWords], " ")] {
+				distinct = false
+				break
+			}
+		}
+		for _, def := range existing {
+			for _, l := range def.Lines {
+				distinct = distinct && !strings.Contains(DefinitionFormat(l), formatted)
+			}
+		}
+		if distinct {
+			picked = append(picked, line)
+		}
+	}
+	if len(picked) == 0 {
+		return definedLicense{}, errors.New("error creating definition: every line of the sample is also in another license")
+	}
+	// Longer lines are less likely to be in other licenses, they are kept in the order of the sample.
+	order := make(map[string]int, len(picked))
+	for i, line := range picked {
+		order[line] = i
+	}
+	sort.SliceStable(picked, func(i, j int) bool {
+		return len(strings.Fields(picked[i])) > len(strings.Fields(picked[j]))
+	})
+	if count > 0 && len(picked) > count {
+		picked = picked[:count]
+	}
+	sort.Slice(picked, func(i, j int) bool {
+		return order[picked[i]] < order[picked[j]]
+	})
+
+	def := definedLicense{Name: name, SPDX: spdx, Lines: picked}
+	if terms, ok := spdxTerms[spdx]; ok {
+		def.Category, def.Obligations = terms.Category, terms.Obligations
+	}
+	return def, nil
+}
+
+// otherLicenseShingles gets every run of minDefinitionWords words of the embedded SPDX licenses except
+// for the license with the SPDX id of the new definition. Runs don't cross the replaceable and optional
+// segments of the licenses.
+func otherLicenseShingles(spdx string) map[string]bool {
+	shingles := make(map[string]bool)
+	for _, l := range spdxlicenses.List() {
+		if l.ID == spdx {
+			continue
+		}
+		template, err := spdxlicenses.Compile(l.Template)
+		if err != nil {
+			continue
+		}
+		for _, run := range template.Segments() {
+			for i := 0; i+minDefinitionWords <= len(run); i++ {
+				shingles[strings.Join(run[i:i+minDefinitionWords], " ")] = true
+			}
+		}
+	}
+	return shingles
+}
+
+// candidateLines splits a license text into sentences and clauses that can be used as definition
+// lines, copyright statements and templates placeholders are left out.
+func candidateLines(text string) []string {
+	text = commentMarker.ReplaceAllString(strings.ReplaceAll(text, "\r", ""), "")
+	var lines []string
+	for _, paragraph := range paragraphBreak.Split(text, -1) {
+		for _, sentence := range sentenceEnd.Split(paragraph, -1) {
+			words := strings.Fields(sentence)
+			if len(words) < minDefinitionWords {
+				continue
+			}
+			if len(words) > maxDefinitionWords {
+				words = words[:maxDefinitionWords]
+			}
+			line := strings.Join(words, " ")
+			if copyrightLine.MatchString(line) || copyrightPlaceholder.MatchString(line) {
+				continue
+			}
+			lines = appendUnique(lines, line)
+		}
+	}
+	return lines
+}
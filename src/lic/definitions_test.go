@@ -0,0 +1,72 @@
+package lic
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testAcme = `Acme Public License 1.0
+
+// Copyright (c) 2024 Acme Corp.
+
+Permission is granted to use this software inside Acme subsidiaries only.
+Redistribution outside of Acme requires a signed distribution agreement with the Acme legal department.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED.
+`
+
+func TestCandidateLines(t *testing.T) {
+	got := candidateLines(testAcme)
+	want := []string{
+		"Permission is granted to use this software inside Acme subsidiaries only",
+		"Redistribution outside of Acme requires a signed distribution agreement with the Acme legal department",
+		`THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected candidate lines:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestAddDefinition(t *testing.T) {
+	config := filepath.Join(t.TempDir(), definedJson)
+	err := os.WriteFile(config, []byte(`[{"Name": "MIT", "SPDX": "MIT", "Lines": ["Permission is hereby granted, free of charge"]}]`), os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("DES_LIC", config)
+
+	def, path, err := AddDefinition("", []byte(testAcme), "Acme Public License", "", categoryProprietary, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != config || len(def.Lines) != 2 || def.Category != categoryProprietary {
+		t.Fatalf("Unexpected definition: %s %+v", path, def)
+	}
+	for _, line := range def.Lines {
+		if strings.Contains(line, "AS IS") {
+			t.Errorf("Lines of other licenses should not be picked: %v", def.Lines)
+		}
+	}
+
+	lics, err := InitLicense("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Scanner{Licenses: lics}
+	if cand, _, ok := s.classify([]byte(testAcme), "LICENSE"); !ok || cand.License != "Acme Public License" {
+		t.Errorf("The new definition should classify the sample: %+v", cand)
+	}
+	if cand, _, ok := s.classify([]byte(testMIT), "LICENSE"); !ok || cand.License != "MIT" {
+		t.Errorf("The new definition should not shadow MIT: %+v", cand)
+	}
+
+	_, _, err = AddDefinition("", []byte(testAcme), "Acme Public License 2", "", "", 3)
+	if err == nil || !strings.Contains(err.Error(), "already classified as Acme Public License") {
+		t.Errorf("A sample that is already classified should be refused: %v", err)
+	}
+	_, _, err = AddDefinition("", []byte(testZlib), "Zlib copy", "", "", 3)
+	if err == nil {
+		t.Error("A sample of another license should be refused")
+	}
+}
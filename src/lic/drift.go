@@ -0,0 +1,333 @@
+package lic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Drift is the result of comparing the licenses of two scans.
+type Drift struct {
+	Added          []DriftChange
+	Removed        []DriftChange
+	VersionChanged []DriftChange
+	LicenseChanged []DriftChange
+}
+
+// DriftChange is a single module that differs between two scans. Licenses and statuses are those
+// of the highest version of the module in each scan.
+type DriftChange struct {
+	Module        string
+	OldVersion    string `json:",omitempty"`
+	NewVersion    string `json:",omitempty"`
+	OldLicenses   []string
+	NewLicenses   []string
+	OldStatus     string `json:",omitempty"`
+	NewStatus     string `json:",omitempty"`
+	CrossesPolicy bool
+}
+
+// scannedVersions holds the licenses found for every version of a module in a scan.
+type scannedVersions map[string][]string
+
+// LoadLicenseTypes reads a LicTypesFile written by a previous scan.
+func LoadLicenseTypes(filename string) (map[string][]LicenseInfo, error) {
+	lt := make(map[string][]LicenseInfo)
+	err := initJsonConfigs(filename, &lt)
+	if err != nil {
+		return nil, err
+	}
+	return lt, nil
+}
+
+// DiffFiles compares two LicTypesFiles.
+func DiffFiles(oldFile, newFile string) (Drift, error) {
+	oldTypes, err := LoadLicenseTypes(oldFile)
+	if err != nil {
+		return Drift{}, err
+	}
+	newTypes, err := LoadLicenseTypes(newFile)
+	if err != nil {
+		return Drift{}, err
+	}
+	pol, err := initPolicy(os.Getenv("GOPATH"))
+	if err != nil {
+		return Drift{}, err
+	}
+	return diffLicenseTypes(oldTypes, newTypes, pol), nil
+}
+
+// DiffRefs scans the repo of the launcher at two refs and compares the results.
+func DiffRefs(l Launch, from, to string) (Drift, error) {
+	results := make([]map[string][]LicenseInfo, 0, 2)
+	for _, ref := range []string{from, to} {
+		dst, err := os.MkdirTemp("", "lic-col-diff-")
+		if err != nil {
+			return Drift{}, fmt.Errorf("error making diff directory: %w", err)
+		}
+		defer os.RemoveAll(dst)
+		launcher := l
+		launcher.Ref = ref
+		launcher.Dst = dst
+		log.Println("Scanning ref: ", ref)
+		err = launcher.LaunchProgram()
+		if err != nil {
+			return Drift{}, fmt.Errorf("error scanning ref: ref: %s err: %w", ref, err)
+		}
+		results = append(results, launcher.Scanner.LicenseType)
+	}
+	pol, err := initPolicy(os.Getenv("GOPATH"))
+	if err != nil {
+		return Drift{}, err
+	}
+	return diffLicenseTypes(results[0], results[1], pol), nil
+}
+
+// diffLicenseTypes compares two LicenseType maps module by module.
+func diffLicenseTypes(oldTypes, newTypes map[string][]LicenseInfo, pol policy) Drift {
+	oldMods := modulesFromLicenseTypes(oldTypes)
+	newMods := modulesFromLicenseTypes(newTypes)
+	drift := Drift{}
+	for _, mod := range sortedModules(oldMods, newMods) {
+		oldVers, inOld := oldMods[mod]
+		newVers, inNew := newMods[mod]
+		change := DriftChange{Module: mod}
+		if inOld {
+			change.OldVersion = oldVers.latest()
+			change.OldLicenses = oldVers[change.OldVersion]
+			change.OldStatus = pol.evaluateAll(change.OldLicenses)
+		}
+		if inNew {
+			change.NewVersion = newVers.latest()
+			change.NewLicenses = newVers[change.NewVersion]
+			change.NewStatus = pol.evaluateAll(change.NewLicenses)
+		}
+		switch {
+		case !inOld:
+			change.CrossesPolicy = change.NewStatus != statusAllowed
+			drift.Added = append(drift.Added, change)
+			continue
+		case !inNew:
+			drift.Removed = append(drift.Removed, change)
+			continue
+		}
+		if change.OldVersion != change.NewVersion {
+			drift.VersionChanged = append(drift.VersionChanged, change)
+		}
+		if strings.Join(change.OldLicenses, "\n") != strings.Join(change.NewLicenses, "\n") {
+			change.CrossesPolicy = statusRank(change.NewStatus) > statusRank(change.OldStatus)
+			drift.LicenseChanged = append(drift.LicenseChanged, change)
+		}
+	}
+	return drift
+}
+
+// CrossesPolicy is true if any change made a module's policy status worse.
+func (d Drift) CrossesPolicy() bool {
+	for _, list := range [][]DriftChange{d.Added, d.LicenseChanged} {
+		for _, c := range list {
+			if c.CrossesPolicy {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Write writes a human readable report of the drift.
+func (d Drift) Write(w io.Writer) {
+	section := func(title string, changes []DriftChange, line func(c DriftChange) string) {
+		fmt.Fprintf(w, "%s (%d)\n", title, len(changes))
+		for _, c := range changes {
+			mark := ""
+			if c.CrossesPolicy {
+				mark = "  POLICY"
+			}
+			fmt.Fprintf(w, "  %s%s\n", line(c), mark)
+		}
+	}
+	section("Added", d.Added, func(c DriftChange) string {
+		return fmt.Sprintf("%s@%s %v (%s)", c.Module, c.NewVersion, c.NewLicenses, c.NewStatus)
+	})
+	section("Removed", d.Removed, func(c DriftChange) string {
+		return fmt.Sprintf("%s@%s %v", c.Module, c.OldVersion, c.OldLicenses)
+	})
+	section("Version changed", d.VersionChanged, func(c DriftChange) string {
+		return fmt.Sprintf("%s %s -> %s", c.Module, c.OldVersion, c.NewVersion)
+	})
+	section("License changed", d.LicenseChanged, func(c DriftChange) string {
+		return fmt.Sprintf("%s@%s %v (%s) -> @%s %v (%s)", c.Module, c.OldVersion, c.OldLicenses, c.OldStatus, c.NewVersion, c.NewLicenses, c.NewStatus)
+	})
+}
+
+// WriteJSON writes the drift as json.
+func (d Drift) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "   ")
+	return enc.Encode(d)
+}
+
+// modulesFromLicenseTypes regroups a LicenseType map by module and version. Files of the scanned
+// repo itself have no version and are skipped, as are NOTICE files.
+func modulesFromLicenseTypes(lt map[string][]LicenseInfo) map[string]scannedVersions {
+	mods := make(map[string]scannedVersions)
+	for lic, infos := range lt {
+		if lic == noticeFile {
+			continue
+		}
+		for _, info := range infos {
+			mod, ver := splitModuleVersion(info.Filename)
+			if mod == "" {
+				continue
+			}
+			vers, ok := mods[mod]
+			if !ok {
+				vers = make(scannedVersions)
+				mods[mod] = vers
+			}
+			vers[ver] = appendUnique(vers[ver], lic)
+		}
+	}
+	for _, vers := range mods {
+		for _, lics := range vers {
+			sort.Strings(lics)
+		}
+	}
+	return mods
+}
+
+// splitModuleVersion gets the module path and version from a LicenseInfo Filename
+// (example: github.com/!j!c!price0024/lic-col@v1.0.0/LICENSE).
+func splitModuleVersion(filename string) (string, string) {
+	filename = strings.ReplaceAll(filepath.ToSlash(filename), `\`, "/")
+	parts := strings.Split(filename, "/")
+	for i, part := range parts {
+		at := strings.LastIndex(part, "@")
+		if at < 0 {
+			continue
+		}
+		mod := strings.Join(append(parts[:i:i], part[:at]), "/")
+		return unescapeModulePath(mod), unescapeModulePath(part[at+1:])
+	}
+	return "", ""
+}
+
+// escapedCapital matches a capital letter escaped by escapeModulePath.
+var escapedCapital = regexp.MustCompile(`![a-z]`)
+
+// unescapeModulePath reverses escapeModulePath.
+func unescapeModulePath(mod string) string {
+	return escapedCapital.ReplaceAllStringFunc(mod, func(s string) string { return strings.ToUpper(s[1:]) })
+}
+
+// latest gets the highest version.
+func (v scannedVersions) latest() string {
+	latest := ""
+	for ver := range v {
+		if latest == "" || compareVersions(ver, latest) > 0 {
+			latest = ver
+		}
+	}
+	return latest
+}
+
+// sortedModules gets the sorted union of the module names of both scans.
+func sortedModules(a, b map[string]scannedVersions) []string {
+	mods := make([]string, 0, len(a)+len(b))
+	for mod := range a {
+		mods = append(mods, mod)
+	}
+	for mod := range b {
+		if _, ok := a[mod]; !ok {
+			mods = append(mods, mod)
+		}
+	}
+	sort.Strings(mods)
+	return mods
+}
+
+// compareVersions compares two module versions by semver precedence. Build metadata is ignored and
+// pre-release versions are compared by their dot separated identifiers.
+func compareVersions(a, b string) int {
+	split := func(v string) ([]int, string) {
+		v = strings.TrimSuffix(strings.TrimPrefix(v, "v"), "+incompatible")
+		if i := strings.Index(v, "+"); i >= 0 {
+			v = v[:i]
+		}
+		pre := ""
+		if i := strings.Index(v, "-"); i >= 0 {
+			v, pre = v[:i], v[i+1:]
+		}
+		nums := make([]int, 3)
+		for i, n := range strings.SplitN(v, ".", 3) {
+			nums[i], _ = strconv.Atoi(n)
+		}
+		return nums, pre
+	}
+	an, ap := split(a)
+	bn, bp := split(b)
+	for i := range an {
+		if an[i] != bn[i] {
+			if an[i] < bn[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case ap == bp:
+		return 0
+	case ap == "":
+		return 1
+	case bp == "":
+		return -1
+	}
+	return comparePrerelease(ap, bp)
+}
+
+// comparePrerelease compares two pre-releases identifier by identifier (example: rc.9 < rc.10 < rc.10.1).
+// Numeric identifiers are compared numerically and are lower than alphanumeric ones.
+func comparePrerelease(a, b string) int {
+	numeric := func(id string) bool {
+		return id != "" && strings.Trim(id, "0123456789") == ""
+	}
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		x, y := as[i], bs[i]
+		if x == y {
+			continue
+		}
+		switch {
+		case numeric(x) && numeric(y):
+			// Numbers can be longer than an int, a shorter number without leading zeros is lower.
+			if len(x) != len(y) {
+				if len(x) < len(y) {
+					return -1
+				}
+				return 1
+			}
+		case numeric(x):
+			return -1
+		case numeric(y):
+			return 1
+		}
+		if x < y {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,52 @@
+package lic
+
+import (
+	"testing"
+)
+
+func TestDiffLicenseTypes(t *testing.T) {
+	info := func(filename string) LicenseInfo { return LicenseInfo{Filename: filename} }
+	oldTypes := map[string][]LicenseInfo{
+		"Mozilla Public": {info(`github.com\!hashi!corp\tool@v1.0.0\LICENSE`)},
+		"MIT":            {info("example.com/same@v1.0.0/LICENSE"), info("example.com/gone@v0.1.0/LICENSE"), info("github.com/owner/repo/LICENSE")},
+	}
+	newTypes := map[string][]LicenseInfo{
+		unknownLicense: {info("github.com/!hashi!corp/tool@v1.2.0/LICENSE")},
+		"MIT":          {info("example.com/same@v1.1.0/LICENSE")},
+		"GPL":          {info("example.com/new@v2.0.0/LICENSE")},
+	}
+	drift := diffLicenseTypes(oldTypes, newTypes, policy{Deny: []string{"GPL"}})
+	if len(drift.Added) != 1 || drift.Added[0].Module != "example.com/new" || !drift.Added[0].CrossesPolicy {
+		t.Fatalf("Unexpected added: %+v", drift.Added)
+	}
+	if len(drift.Removed) != 1 || drift.Removed[0].Module != "example.com/gone" {
+		t.Fatalf("Unexpected removed: %+v", drift.Removed)
+	}
+	if len(drift.VersionChanged) != 2 {
+		t.Fatalf("Unexpected version changes: %+v", drift.VersionChanged)
+	}
+	changed := drift.LicenseChanged
+	if len(changed) != 1 || changed[0].Module != "github.com/HashiCorp/tool" || changed[0].OldStatus != statusAllowed || changed[0].NewStatus != statusReview || !changed[0].CrossesPolicy {
+		t.Fatalf("Unexpected license changes: %+v", changed)
+	}
+	if !drift.CrossesPolicy() {
+		t.Fatal("Expected drift to cross the policy")
+	}
+	if compareVersions("v1.10.0", "v1.9.0") <= 0 || compareVersions("v1.0.0-rc1", "v1.0.0") >= 0 {
+		t.Fatal("Unexpected version ordering")
+	}
+	ordered := []string{"v1.0.0-alpha", "v1.0.0-alpha.1", "v1.0.0-alpha.beta", "v1.0.0-rc.2", "v1.0.0-rc.9",
+		"v1.0.0-rc.10", "v1.0.0-rc.10.1", "v1.0.0", "v1.0.1-0.20230101120000-abcdef123456", "v1.0.1"}
+	for i := 1; i < len(ordered); i++ {
+		if compareVersions(ordered[i-1], ordered[i]) >= 0 || compareVersions(ordered[i], ordered[i-1]) <= 0 {
+			t.Errorf("Expected %s < %s", ordered[i-1], ordered[i])
+		}
+	}
+	if compareVersions("v1.0.0-rc.1+build.2", "v1.0.0-rc.1") != 0 {
+		t.Error("Build metadata should be ignored")
+	}
+	versions := scannedVersions{"v1.0.0-rc.9": nil, "v1.0.0-rc.10": nil}
+	if versions.latest() != "v1.0.0-rc.10" {
+		t.Error("Expected rc.10 to be the latest version")
+	}
+}
@@ -0,0 +1,279 @@
+package lic
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/JCPrice0024/lic-col/src/lic/spdxlicenses"
+)
+
+// maxDiffEdits caps the number of word edits of a diff, texts that differ more aren't diffed.
+const maxDiffEdits = 1000
+
+// Explanation tells how well a file matches one of the defined licenses.
+type Explanation struct {
+	License string
+	SPDX    string
+	Kind    string  // lines or spdx-template.
+	Score   float64 // The share of lines found in the file, for SPDX templates the similarity to the file.
+	Matched bool    // True if the definition classifies the file.
+	Lines   []LineResult
+	Diff    []DiffChunk // Word diff of the definition against the file, only set for the nearest miss.
+}
+
+// LineResult tells if a line of a definition was found in the file.
+type LineResult struct {
+	Line    string
+	Matched bool
+}
+
+// DiffChunk is a run of words of a word diff. Op is = for words in both texts, - for words of the
+// definition that are missing in the file and + for words of the file that aren't in the definition.
+type DiffChunk struct {
+	Op    string
+	Words []string
+}
+
+// Explain ranks the defined licenses by how well the file matches them and returns the top ones,
+// all of them if top is 0. The best ranked definition that doesn't match the file gets a word diff.
+func Explain(defs licenses, bs []byte, top int) []Explanation {
+	licDef := DefinitionFormat(string(bs))
+	words := spdxlicenses.Words(string(bs))
+	set := spdxlicenses.WordSet(words)
+	explanations := make([]Explanation, 0, len(defs))
+	for _, def := range defs {
+		e := Explanation{License: def.Name, SPDX: def.SPDX, Kind: "lines"}
+		if def.template != nil {
+			e.Kind = "spdx-template"
+			e.Matched = def.template.Match(words, set)
+			e.Score = def.template.Similarity(words)
+			if e.Matched {
+				e.Score = 1
+			}
+		} else {
+			e.Matched = TestLicense(licDef, def, false)
+			found := 0
+			for i, line := range def.Lines {
+				ok := strings.Contains(licDef, line)
+				if ok {
+					found++
+				}
+				e.Lines = append(e.Lines, LineResult{Line: def.rawLine(i), Matched: ok})
+			}
+			if len(def.Lines) > 0 {
+				e.Score = float64(found) / float64(len(def.Lines))
+			}
+		}
+		explanations = append(explanations, e)
+	}
+	sort.SliceStable(explanations, func(i, j int) bool {
+		if explanations[i].Score != explanations[j].Score {
+			return explanations[i].Score > explanations[j].Score
+		}
+		return explanations[i].Matched && !explanations[j].Matched
+	})
+	if top > 0 && top < len(explanations) {
+		explanations = explanations[:top]
+	}
+	for i, e := range explanations {
+		if e.Matched {
+			continue
+		}
+		def, _ := defs.find(e.License)
+		explanations[i].Diff = def.diff(bs, words)
+		break
+	}
+	return explanations
+}
+
+// rawLine gets a line of the definition as it was written in the config, definitions that weren't
+// loaded by InitLicense only have the formatted line.
+func (def definedLicense) rawLine(i int) string {
+	if i < len(def.rawLines) {
+		return def.rawLines[i]
+	}
+	return def.Lines[i]
+}
+
+// diff creates the word diff of the definition against the words of a file. An SPDX template is diffed
+// as a whole without its optional and replaceable segments, of a line definition every missing line is
+// diffed against the part of the file that is closest to it.
+func (def definedLicense) diff(bs []byte, words []string) []DiffChunk {
+	if def.template != nil {
+		chunks, _ := wordDiff(def.template.RequiredWords(), words, maxDiffEdits)
+		return chunks
+	}
+	licDef := DefinitionFormat(string(bs))
+	var chunks []DiffChunk
+	for i, line := range def.Lines {
+		if strings.Contains(licDef, line) {
+			continue
+		}
+		lineWords := spdxlicenses.Words(def.rawLine(i))
+		window := closestWindow(lineWords, words)
+		lineChunks, ok := wordDiff(lineWords, window, maxDiffEdits)
+		if !ok {
+			continue
+		}
+		// The window is longer than the line, extra words at its edges aren't differences.
+		for len(lineChunks) > 0 && lineChunks[0].Op == "+" {
+			lineChunks = lineChunks[1:]
+		}
+		for len(lineChunks) > 0 && lineChunks[len(lineChunks)-1].Op == "+" {
+			lineChunks = lineChunks[:len(lineChunks)-1]
+		}
+		chunks = append(chunks, lineChunks...)
+	}
+	return chunks
+}
+
+// closestWindow gets the part of the words that shares the most words in order with the line.
+func closestWindow(line, words []string) []string {
+	if len(line) == 0 {
+		return nil
+	}
+	size := len(line) + len(line)/2 + 1
+	var best []string
+	bestScore := -1
+	for start := range words {
+		if words[start] != line[0] && (len(line) < 2 || words[start] != line[1]) {
+			continue
+		}
+		end := start + size
+		if end > len(words) {
+			end = len(words)
+		}
+		score := commonWords(line, words[start:end])
+		if score > bestScore {
+			best, bestScore = words[start:end], score
+		}
+	}
+	return best
+}
+
+// commonWords gets the length of the longest common subsequence of two short lists of words.
+func commonWords(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			switch {
+			case a[i] == b[j]:
+				cur[j+1] = prev[j] + 1
+			case prev[j+1] > cur[j]:
+				cur[j+1] = prev[j+1]
+			default:
+				cur[j+1] = cur[j]
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// wordDiff creates the shortest word diff of a against b with the Myers algorithm, it fails if the
+// texts need more than maxEdits edits.
+func wordDiff(a, b []string, maxEdits int) ([]DiffChunk, bool) {
+	n, m := len(a), len(b)
+	if maxEdits > n+m {
+		maxEdits = n + m
+	}
+	offset := maxEdits + 1
+	v := make([]int, 2*maxEdits+3)
+	var trace [][]int
+	for d := 0; d <= maxEdits; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				trace = append(trace, append([]int{}, v...))
+				return backtrack(trace, a, b, offset), true
+			}
+		}
+		trace = append(trace, append([]int{}, v...))
+	}
+	return nil, false
+}
+
+// backtrack walks the trace of wordDiff back from the end of both texts and creates the chunks.
+func backtrack(trace [][]int, a, b []string, offset int) []DiffChunk {
+	type edit struct {
+		op   string
+		word string
+	}
+	var edits []edit
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d-1]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			edits = append(edits, edit{"=", a[x-1]})
+			x, y = x-1, y-1
+		}
+		if x == prevX {
+			edits = append(edits, edit{"+", b[y-1]})
+		} else {
+			edits = append(edits, edit{"-", a[x-1]})
+		}
+		x, y = prevX, prevY
+	}
+	for x > 0 && y > 0 {
+		edits = append(edits, edit{"=", a[x-1]})
+		x, y = x-1, y-1
+	}
+	var chunks []DiffChunk
+	for i := len(edits) - 1; i >= 0; i-- {
+		e := edits[i]
+		if len(chunks) > 0 && chunks[len(chunks)-1].Op == e.op {
+			chunks[len(chunks)-1].Words = append(chunks[len(chunks)-1].Words, e.word)
+			continue
+		}
+		chunks = append(chunks, DiffChunk{Op: e.op, Words: []string{e.word}})
+	}
+	return chunks
+}
+
+// FormatDiff writes a word diff in the style of wdiff: [-missing words-] and {+extra words+}. Runs of
+// equal words longer than twice the context are shortened to ...
+func FormatDiff(chunks []DiffChunk, context int) string {
+	var parts []string
+	for i, c := range chunks {
+		switch c.Op {
+		case "-":
+			parts = append(parts, "[-"+strings.Join(c.Words, " ")+"-]")
+		case "+":
+			parts = append(parts, "{+"+strings.Join(c.Words, " ")+"+}")
+		default:
+			words := c.Words
+			first, last := i == 0, i == len(chunks)-1
+			switch {
+			case first && len(words) > context:
+				words = append([]string{"..."}, words[len(words)-context:]...)
+			case last && len(words) > context:
+				words = append(append([]string{}, words[:context]...), "...")
+			case len(words) > 2*context:
+				words = append(append(append([]string{}, words[:context]...), "..."), words[len(words)-context:]...)
+			}
+			parts = append(parts, strings.Join(words, " "))
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,52 @@
+package lic
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWordDiff(t *testing.T) {
+	a := strings.Fields("the quick brown fox jumps over the lazy dog")
+	b := strings.Fields("the quick red fox jumps over the dog today")
+	chunks, ok := wordDiff(a, b, maxDiffEdits)
+	if !ok {
+		t.Fatal("Diff should be found")
+	}
+	got := FormatDiff(chunks, 10)
+	want := "the quick [-brown-] {+red+} fox jumps over the [-lazy-] dog {+today+}"
+	if got != want {
+		t.Errorf("Unexpected diff:\n got: %s\nwant: %s", got, want)
+	}
+	if got := FormatDiff(chunks, 1); got != "... quick [-brown-] {+red+} fox ... the [-lazy-] dog {+today+}" {
+		t.Errorf("Unexpected shortened diff: %s", got)
+	}
+	if _, ok := wordDiff(a, b, 2); ok {
+		t.Error("Diff needs more than 2 edits")
+	}
+}
+
+func TestExplain(t *testing.T) {
+	lines := licenses{
+		{Name: "Zlib lines", rawLines: []string{"Permission is granted to anyone", "Altered source versions must be plainly marked"},
+			Lines: []string{DefinitionFormat("Permission is granted to anyone"), DefinitionFormat("Altered source versions must be plainly marked")}},
+		{Name: "Other", Lines: []string{DefinitionFormat("Something else entirely")}},
+	}
+	defs := append(lines, spdxDefinitions(lines)...)
+	changed := strings.Replace(testZlib, "must be plainly marked", "must be marked", 1)
+	explanations := Explain(defs, []byte(changed), 3)
+	if len(explanations) != 3 {
+		t.Fatalf("Unexpected explanations: %+v", explanations)
+	}
+	best := explanations[0]
+	if best.License != "zlib License" || best.Matched || best.Score < similarityThreshold {
+		t.Fatalf("The zlib template should be the nearest miss: %+v", best)
+	}
+	if got := FormatDiff(best.Diff, 2); !strings.Contains(got, "must be [-plainly-] marked") {
+		t.Errorf("Unexpected diff: %s", got)
+	}
+	for _, e := range explanations {
+		if e.License == "Zlib lines" && (e.Score != 0.5 || !e.Lines[0].Matched || e.Lines[1].Matched || e.Lines[1].Line != "Altered source versions must be plainly marked") {
+			t.Errorf("Unexpected line explanation: %+v", e)
+		}
+	}
+}
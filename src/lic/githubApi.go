@@ -1,6 +1,7 @@
 package lic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -28,9 +29,9 @@ type license struct {
 // getRepoInfo makes a http.Request to the github api and gets a License name, if available,
 // from the repo currently being scanned. This can only be used if the user provides a
 // git-token and git-username.
-func getRepoInfo(owner, repoName, username, token string) (repo, error) {
+func getRepoInfo(ctx context.Context, owner, repoName, username, token string) (repo, error) {
 	repo := repo{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("https://api.github.com/repos/%s/%s", owner, repoName), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("https://api.github.com/repos/%s/%s", owner, repoName), nil)
 	if err != nil {
 		return repo, err
 	}
@@ -88,16 +89,25 @@ func parseIntLogging(field, input string) int64 {
 }
 
 // calcGitApiSleep is a function that prevents overloading the gitapi with too many requests
-// in an hour. DO NOT REMOVE THIS FUNCTION!
-func (r *repo) calcGitApiSleep() (nearLimit bool) {
+// in an hour. DO NOT REMOVE THIS FUNCTION! The wait is cut short and the error of the context
+// returned once the context is done.
+func (r *repo) calcGitApiSleep(ctx context.Context) (nearLimit bool, err error) {
 	if r.Remaining > r.Limit/2 {
-		time.Sleep(time.Millisecond * 50)
-		return false
+		return false, sleepContext(ctx, time.Millisecond*50)
 	}
 	if r.Remaining < minimumRem {
 		log.Printf("TOKEN REQUEST NEARING LIMIT STOPPING GITHUB API CALL TRY AGAIN AT: %v", r.Reset)
-		return true
+		return true, nil
+	}
+	return false, sleepContext(ctx, time.Second*2)
+}
+
+// sleepContext waits for d or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
 	}
-	time.Sleep(time.Second * 2)
-	return false
 }
@@ -0,0 +1,320 @@
+package lic
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+)
+
+// errProxyNotFound is returned when a proxy does not have the requested file, this lets the
+// fetcher fall through to the next proxy in the list.
+var errProxyNotFound = errors.New("not found on proxy")
+
+// proxyFetcher is a struct that downloads module information from a list of GOPROXY urls. It
+// supports http(s) proxies, file:// proxies laid out like $GOPATH/pkg/mod/cache/download and
+// file:// Athens disk storage.
+type proxyFetcher struct {
+	Proxies []proxyEntry
+	Client  *http.Client
+}
+
+// proxyEntry is a single url from a GOPROXY list. FallThrough is true when the entry was
+// followed by a "|" meaning any error moves on to the next proxy, not only a not found.
+type proxyEntry struct {
+	URL         string
+	FallThrough bool
+}
+
+// moduleInfo is the struct returned by the .info endpoint of a GOPROXY.
+type moduleInfo struct {
+	Version string
+	Time    time.Time
+}
+
+// sumEntry is a single module from a go.sum file. ZipHash is empty if the go.sum only holds
+// the go.mod hash for the module.
+type sumEntry struct {
+	Module  string
+	Version string
+	ZipHash string
+}
+
+// newProxyFetcher creates a proxyFetcher from a GOPROXY formatted string.
+func newProxyFetcher(goproxy string) (*proxyFetcher, error) {
+	fetcher := &proxyFetcher{Client: &http.Client{Timeout: 5 * time.Minute}}
+	rest := strings.TrimSpace(goproxy)
+	for rest != "" {
+		var entry string
+		fallThrough := false
+		i := strings.IndexAny(rest, ",|")
+		if i < 0 {
+			entry, rest = rest, ""
+		} else {
+			entry, fallThrough, rest = rest[:i], rest[i] == '|', rest[i+1:]
+		}
+		entry = strings.TrimSpace(entry)
+		switch entry {
+		case "":
+			continue
+		case "off":
+			fetcher.Proxies = append(fetcher.Proxies, proxyEntry{URL: entry})
+			continue
+		case "direct":
+			log.Println("GOPROXY direct is not supported by the proxy fetcher, skipping it")
+			continue
+		}
+		if !strings.HasPrefix(entry, "file://") && !strings.HasPrefix(entry, "http://") && !strings.HasPrefix(entry, "https://") {
+			return nil, fmt.Errorf("invalid proxy url: %s", entry)
+		}
+		fetcher.Proxies = append(fetcher.Proxies, proxyEntry{URL: strings.TrimSuffix(entry, "/"), FallThrough: fallThrough})
+	}
+	if len(fetcher.Proxies) == 0 {
+		return nil, fmt.Errorf("no usable proxies in: %q", goproxy)
+	}
+	return fetcher, nil
+}
+
+// versions gets the list of known versions of a module from the @v/list endpoint.
+func (p *proxyFetcher) versions(ctx context.Context, mod string) ([]string, error) {
+	bs, err := p.fetch(ctx, mod, "", "list")
+	if err != nil {
+		return nil, err
+	}
+	var vers []string
+	for _, v := range strings.Split(string(bs), "\n") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			vers = append(vers, v)
+		}
+	}
+	sort.Strings(vers)
+	return vers, nil
+}
+
+// info gets the version info of a module from the .info endpoint.
+func (p *proxyFetcher) info(ctx context.Context, mod, ver string) (moduleInfo, error) {
+	info := moduleInfo{}
+	bs, err := p.fetch(ctx, mod, ver, "info")
+	if err != nil {
+		return info, err
+	}
+	err = json.Unmarshal(bs, &info)
+	if err != nil {
+		return info, fmt.Errorf("error decoding info: module: %s@%s err: %w", mod, ver, err)
+	}
+	return info, nil
+}
+
+// zip gets the module zip from the .zip endpoint.
+func (p *proxyFetcher) zip(ctx context.Context, mod, ver string) ([]byte, error) {
+	return p.fetch(ctx, mod, ver, "zip")
+}
+
+// fetchModule downloads the module zip and verifies it against the go.sum hash.
+func (p *proxyFetcher) fetchModule(ctx context.Context, e sumEntry) (*zip.Reader, error) {
+	_, err := p.info(ctx, e.Module, e.Version)
+	if err != nil {
+		if errors.Is(err, errProxyNotFound) {
+			vers, listErr := p.versions(ctx, e.Module)
+			if listErr == nil {
+				return nil, fmt.Errorf("version %s of %s not found on proxy, known versions: %v", e.Version, e.Module, vers)
+			}
+		}
+		return nil, err
+	}
+	bs, err := p.zip(ctx, e.Module, e.Version)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(bs), int64(len(bs)))
+	if err != nil {
+		return nil, fmt.Errorf("error reading module zip: module: %s@%s err: %w", e.Module, e.Version, err)
+	}
+	hash, err := hashZip(zr)
+	if err != nil {
+		return nil, err
+	}
+	if hash != e.ZipHash {
+		return nil, fmt.Errorf("checksum mismatch: module: %s@%s go.sum: %s downloaded: %s", e.Module, e.Version, e.ZipHash, hash)
+	}
+	return zr, nil
+}
+
+// fetch tries every proxy in order for the given module file. kind is one of list, info or zip.
+func (p *proxyFetcher) fetch(ctx context.Context, mod, ver, kind string) ([]byte, error) {
+	escMod := escapeModulePath(mod)
+	rel := path.Join(escMod, "@v", "list")
+	if kind != "list" {
+		rel = path.Join(escMod, "@v", escapeModulePath(ver)+"."+kind)
+	}
+	var lastErr error
+	for _, proxy := range p.Proxies {
+		if proxy.URL == "off" {
+			return nil, fmt.Errorf("module lookup disabled by GOPROXY=off: %s", rel)
+		}
+		var bs []byte
+		var err error
+		if strings.HasPrefix(proxy.URL, "file://") {
+			bs, err = fetchFile(proxy.URL, rel, escMod, escapeModulePath(ver), kind)
+		} else {
+			bs, err = p.fetchHTTP(ctx, proxy.URL+"/"+rel)
+		}
+		if err == nil {
+			return bs, nil
+		}
+		lastErr = err
+		if !proxy.FallThrough && !errors.Is(err, errProxyNotFound) {
+			return nil, err
+		}
+	}
+	return nil, lastErr
+}
+
+// fetchHTTP performs a GET on the given url.
+func (p *proxyFetcher) fetchHTTP(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating proxy request: url: %s err: %w", u, err)
+	}
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting proxy: url: %s err: %w", u, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return nil, fmt.Errorf("%w: %s", errProxyNotFound, u)
+	}
+	if isBadResp(resp) {
+		return nil, fmt.Errorf("received invalid status code: url: %s code: %v", u, resp.StatusCode)
+	}
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading proxy response: url: %s err: %w", u, err)
+	}
+	return bs, nil
+}
+
+// fetchFile reads a module file from a file:// proxy. If the file isn't in the GOPROXY layout
+// it falls back to the Athens disk storage layout (<module>/<version>/source.zip).
+func fetchFile(proxyURL, rel, escMod, escVer, kind string) ([]byte, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing proxy url: %w", err)
+	}
+	root := u.Path
+	if runtime.GOOS == "windows" {
+		root = strings.TrimPrefix(root, "/")
+	}
+	root = filepath.FromSlash(root)
+	bs, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(rel)))
+	if err == nil {
+		return bs, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error reading proxy file: %w", err)
+	}
+	athens := filepath.Join(root, filepath.FromSlash(escMod))
+	switch kind {
+	case "list":
+		entries, dirErr := os.ReadDir(athens)
+		if dirErr != nil {
+			break
+		}
+		var vers []string
+		for _, e := range entries {
+			if e.IsDir() && strings.HasPrefix(e.Name(), "v") {
+				vers = append(vers, e.Name())
+			}
+		}
+		return []byte(strings.Join(vers, "\n")), nil
+	case "info":
+		bs, err = os.ReadFile(filepath.Join(athens, escVer, escVer+".info"))
+	case "zip":
+		bs, err = os.ReadFile(filepath.Join(athens, escVer, "source.zip"))
+	}
+	if err == nil {
+		return bs, nil
+	}
+	return nil, fmt.Errorf("%w: %s", errProxyNotFound, filepath.Join(root, filepath.FromSlash(rel)))
+}
+
+// escapeModulePath escapes capital letters the same way the module cache and GOPROXY
+// protocol do (example: github.com/JCPrice0024 becomes github.com/!j!c!price0024).
+func escapeModulePath(mod string) string {
+	var b strings.Builder
+	for _, r := range mod {
+		if 'A' <= r && r <= 'Z' {
+			b.WriteByte('!')
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// hashZip computes the go.sum h1: hash of a module zip.
+func hashZip(zr *zip.Reader) (string, error) {
+	files := make([]*zip.File, 0, len(zr.File))
+	for _, f := range zr.File {
+		if strings.Contains(f.Name, "\n") {
+			return "", fmt.Errorf("invalid filename in module zip: %q", f.Name)
+		}
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	h := sha256.New()
+	for _, f := range files {
+		r, err := f.Open()
+		if err != nil {
+			return "", fmt.Errorf("error opening zip file: file: %s err: %w", f.Name, err)
+		}
+		fh := sha256.New()
+		_, err = io.Copy(fh, r)
+		r.Close()
+		if err != nil {
+			return "", fmt.Errorf("error hashing zip file: file: %s err: %w", f.Name, err)
+		}
+		fmt.Fprintf(h, "%x  %s\n", fh.Sum(nil), f.Name)
+	}
+	return "h1:" + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// parseGoSum reads the contents of a go.sum file into a list of modules.
+func parseGoSum(sum string) []sumEntry {
+	var entries []sumEntry
+	index := make(map[string]int)
+	for _, line := range strings.Split(sum, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		ver := strings.TrimSuffix(fields[1], "/go.mod")
+		key := fields[0] + "@" + ver
+		i, ok := index[key]
+		if !ok {
+			i = len(entries)
+			index[key] = i
+			entries = append(entries, sumEntry{Module: fields[0], Version: ver})
+		}
+		if ver == fields[1] {
+			entries[i].ZipHash = fields[2]
+		}
+	}
+	return entries
+}
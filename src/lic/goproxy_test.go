@@ -0,0 +1,159 @@
+package lic
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testGopath creates an empty GOPATH with a Config folder so the scanner can write its cache.
+func testGopath(t *testing.T) string {
+	gopath := t.TempDir()
+	err := os.MkdirAll(filepath.Join(gopath, "src", "github.com", "JCPrice0024", "lic-col", "Config"), os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GOPATH", gopath)
+	return gopath
+}
+
+// testProxy is a file:// GOPROXY in a temporary directory and the go.sum lines of the modules in it.
+type testProxy struct {
+	t   *testing.T
+	Dir string
+	Sum string
+}
+
+// newTestProxy creates an empty testProxy.
+func newTestProxy(t *testing.T) *testProxy {
+	return &testProxy{t: t, Dir: t.TempDir()}
+}
+
+// URL gets the GOPROXY serving the modules of the proxy.
+func (p *testProxy) URL() string {
+	return "file://" + filepath.ToSlash(p.Dir)
+}
+
+// add writes a module zip into the proxy in the GOPROXY layout, adds its line to the Sum and returns its h1 hash.
+func (p *testProxy) add(mod, ver string, files map[string]string) string {
+	t := p.t
+	t.Helper()
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for name, data := range files {
+		w, err := zw.Create(mod + "@" + ver + "/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = w.Write([]byte(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := zw.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(p.Dir, filepath.FromSlash(escapeModulePath(mod)), "@v")
+	err = os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range map[string][]byte{
+		ver + ".zip":  buf.Bytes(),
+		ver + ".info": []byte(`{"Version":"` + ver + `"}`),
+		"list":        []byte(ver + "\n"),
+	} {
+		err = os.WriteFile(filepath.Join(dir, name), data, os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := hashZip(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Sum += mod + " " + ver + " " + hash + "\n"
+	return hash
+}
+
+// newProxyScanner creates a scanner in a new GOPATH that fetches the modules of the proxy Sum and writes
+// its report into dst/name_Licenses. Its fields can be changed before ScanPath is called.
+func newProxyScanner(t *testing.T, proxy *testProxy, dst, name string) *Scanner {
+	t.Helper()
+	gopath := testGopath(t)
+	scan, err := initScanner(gopath, filepath.Join(gopath, "pkg", "mod"), dst, "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan.Fetcher, err = newProxyFetcher(proxy.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan.LicFolder = name + "_Licenses"
+	err = os.MkdirAll(filepath.Join(dst, scan.LicFolder), os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan.ProjectSum = proxy.Sum
+	return scan
+}
+
+func TestProxyScan(t *testing.T) {
+	proxy := newTestProxy(t)
+	proxy.add("example.com/Owner/mod", "v1.0.0", map[string]string{
+		"LICENSE": "Permission is hereby granted, free of charge",
+		"mod.go":  "package mod",
+	})
+	scan := newProxyScanner(t, proxy, t.TempDir(), "mod")
+	var err error
+	scan.Fetcher, err = newProxyFetcher("https://127.0.0.1:1/missing|" + proxy.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan.ProjectSum += "example.com/Owner/mod v1.0.0/go.mod h1:notchecked=\n"
+	err = scan.ScanPath()
+	if err != nil {
+		t.Fatalf("FAILED SCAN: %v", err)
+	}
+	found := scan.LicenseType[unknownLicense]
+	if len(found) != 1 || !strings.HasSuffix(filepath.ToSlash(found[0].Filename), "example.com/!owner/mod@v1.0.0/LICENSE") {
+		t.Fatalf("EXPECTED one unknown license GOT: %v", scan.LicenseType)
+	}
+
+	scan.Modules = make(map[string]*scannedModule)
+	scan.ProjectSum = "example.com/Owner/mod v1.0.0 h1:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=\n"
+	err = scan.ScanPath()
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("Expected checksum mismatch got: %v", err)
+	}
+}
+
+func TestParseGoSum(t *testing.T) {
+	entries := parseGoSum("a.com/b v1.0.0 h1:zip=\na.com/b v1.0.0/go.mod h1:mod=\nc.com/d v0.1.0/go.mod h1:mod=\n")
+	if len(entries) != 2 {
+		t.Fatalf("EXPECTED 2 entries GOT: %v", entries)
+	}
+	if entries[0].ZipHash != "h1:zip=" || entries[1].ZipHash != "" {
+		t.Fatalf("Unexpected zip hashes: %v", entries)
+	}
+}
+
+func TestEscapeModulePath(t *testing.T) {
+	for mod, want := range map[string]string{
+		"github.com/JCPrice0024/lic-col": "github.com/!j!c!price0024/lic-col",
+		"golang.org/x/mod":               "golang.org/x/mod",
+		"example.com/ÄB":                 "example.com/Ä!b",
+	} {
+		if got := escapeModulePath(mod); got != want {
+			t.Errorf("escapeModulePath(%q) = %q, want %q", mod, got, want)
+		}
+	}
+}
@@ -1,28 +1,26 @@
 package lic
 
 import (
-	"errors"
 	"fmt"
 	"html/template"
-	"os"
-	"path/filepath"
+	"io"
+	"io/fs"
+	"net/url"
 	"strings"
 )
 
-// initLicTemplate creates the template used to convert all License files into .html files.
+// initLicTemplate creates the template used to convert all License files into .html files, the text
+// is kept as is in a pre block.
 func initLicTemplate() *template.Template {
 	layout := `<!DOCTYPE html>
 	<html lang="en">
 	<head>
 		<meta charset="UTF-8">
 	  <title>Scan Results</title>
+	  <style>pre { white-space: pre-wrap; font-family: monospace; max-width: 50em; margin: 2em auto; }</style>
 	</head>
 	<body>
-	 <center>
-	   {{range .}}
-			<br/> {{.}}
-	   {{end}}
-	 </center>
+	 <pre>{{.}}</pre>
 	</body>
 	</html>`
 	return template.Must(template.New("license").Parse(layout))
@@ -33,21 +31,15 @@ func initLicTemplate() *template.Template {
 // The html index also makes a link that can be used to go to the scanned License's current github repo a
 // and it uses the gitapi to get the current repo's license in case it's changed.
 func (l *Launch) createHtmlIndex() error {
-	err := os.Remove(filepath.Join(l.Dst, l.Scanner.LicFolder, "index.html"))
-	if err != nil {
-		if !errors.Is(err, os.ErrNotExist) {
-			return fmt.Errorf("error prepping html: %w", err)
-		}
-	}
-	fileinfo, err := os.OpenFile(filepath.Join(l.Dst, l.Scanner.LicFolder, "index.html"), os.O_CREATE|os.O_RDWR, os.ModePerm)
-	if err != nil {
-		return fmt.Errorf("error opening file: %w", err)
-	}
-	defer fileinfo.Close()
-
+	texts := licenseTexts(l.Scanner.LicenseType)
 	funcMap := template.FuncMap{
-		"safe": func(s string) template.HTML {
-			return template.HTML(s)
+		"relativeURL": relativeURL,
+		"externalURL": externalURL,
+		"uses": func(hash string) int {
+			if text, ok := texts[hash]; ok {
+				return len(text.Modules)
+			}
+			return 0
 		},
 	}
 
@@ -58,52 +50,66 @@ func (l *Launch) createHtmlIndex() error {
 		  <title>Scan Results</title>
 		</head>
 		<body>
-		  <ol>
+		  <p><a href="report.html">Report</a> | <a href="modules.html">By module</a></p>
 		  {{range $i, $val := .}}
 		  <h1>{{$i}}</h1>
+		  <ol>
 			  {{range $j, $val2 := $val}}
-			 	 {{if $val2.GitLicense}}
-				  <p><a href={{$val2.Filepath|safe}}>{{$val2.Filename}}</a>   <a href={{$val2.GitLink|safe}}>Current Repo</a>  <strong>(github api: {{$val2.GitLicense}})</strong>
-			     {{else if $val2.GitLink}}
-			  <p><a href={{$val2.Filepath|safe}}>{{$val2.Filename}}</a>   <a href={{$val2.GitLink|safe}}>Current Repo</a>
-			      {{else}}
-			  <p><a href={{$val2.Filepath|safe}}>{{$val2.Filename}}</a></p>
-			     {{end}}
+			  <li>
+			  <p>{{with relativeURL $val2.Filepath}}<a href="{{.}}">{{$val2.Filename}}</a>{{else}}{{$val2.Filename}}{{end}}
+			  {{with externalURL $val2.GitLink}}   <a href="{{.}}">Current Repo</a>{{end}}
+			  {{if $val2.GitLicense}}  <strong>(github api: {{$val2.GitLicense}})</strong>{{end}}</p>
+			  {{if gt (uses $val2.SHA256) 1}}<p><small>This exact text is used by {{uses $val2.SHA256}} modules</small></p>{{end}}
+			  {{range $val2.Copyrights}}
+			  <p><small>{{.}}</small></p>
+			  {{end}}
+			  </li>
 			  {{end}}
-		  {{end}}
 		  </ol>
+		  {{end}}
 		</body>
 		</html>`
 	tmpl := template.Must(template.New("licenses").Funcs(funcMap).Parse(layout))
-	err = tmpl.Execute(fileinfo, l.Scanner.LicenseType)
-	if err != nil {
-		return fmt.Errorf("error executing html: %w", err)
-	}
-	return nil
+	return l.createReportFile("index.html", func(w io.Writer) error {
+		err := tmpl.Execute(w, l.Scanner.LicenseType)
+		if err != nil {
+			return fmt.Errorf("error executing html: %w", err)
+		}
+		return nil
+	})
 }
 
-// createHTMLLicense copies each indivual license into html to be viewed by the index file.
-func (s *Scanner) createHTMLLicense(licPath string, data []byte) error {
-	licFolder := filepath.Join(s.DstPath, s.LicFolder, "Licenses")
-	err := os.MkdirAll(licFolder, os.ModePerm)
-	if err != nil {
-		return fmt.Errorf("error making license folder directory: %w", err)
+// relativeURL gets the link to a file of the LicFolder. Every segment of the path is escaped, so the !
+// escapes of module paths become %21 and quotes or brackets in a filename can't leave the attribute.
+// Paths that aren't relative to the LicFolder get an empty link, they are shown without one.
+func relativeURL(p string) template.URL {
+	if !fs.ValidPath(p) || p == "." {
+		return ""
 	}
-	licNameExt := licPathCleanup(filepath.Dir(licPath), true)
-
-	dstFileName := filepath.Base(licPath) + licNameExt + ".html"
-
-	dstFile := filepath.Join(licFolder, dstFileName)
-	dFile, err := os.OpenFile(dstFile, os.O_RDWR|os.O_CREATE, 0666)
-	if err != nil {
-		return fmt.Errorf("error with dst file directory: %w", err)
+	segs := strings.Split(p, "/")
+	for i, seg := range segs {
+		segs[i] = url.PathEscape(seg)
 	}
-	defer dFile.Close()
-	lines := strings.Split(string(data), "\n")
+	return template.URL(strings.Join(segs, "/"))
+}
 
-	err = s.Template.Execute(dFile, lines)
-	if err != nil {
-		return fmt.Errorf("error executing html: %w", err)
+// externalURL gets the link if it is an absolute http or https url, anything else (example: a
+// javascript: url) gets an empty link.
+func externalURL(link string) template.URL {
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return ""
 	}
-	return nil
+	return template.URL(u.String())
+}
+
+// createHTMLLicense copies each indivual license into html to be viewed by the index file.
+func (s *Scanner) createHTMLLicense(data []byte) error {
+	return writeReport(s.output(), s.LicFolder, s.copyName(data), func(w io.Writer) error {
+		err := s.Template.Execute(w, string(data))
+		if err != nil {
+			return fmt.Errorf("error executing html: %w", err)
+		}
+		return nil
+	})
 }
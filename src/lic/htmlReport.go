@@ -0,0 +1,233 @@
+package lic
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+)
+
+// reportHtml is the single page html report of the scan, it is made if -tohtml is used.
+const reportHtml = "report.html"
+
+// htmlReport is the data the reportHtml is made from.
+type htmlReport struct {
+	Title      string
+	Project    *ModuleResult
+	Licenses   []licenseSummary
+	Categories []categorySummary
+	Statuses   []string
+	Modules    []ModuleResult
+}
+
+// licenseSummary is a row of the license table of the reportHtml.
+type licenseSummary struct {
+	License  string
+	Category string
+	Status   string
+	Modules  int
+}
+
+// categorySummary is a row of the category table of the reportHtml.
+type categorySummary struct {
+	Category string
+	Modules  int
+}
+
+// newHtmlReport summarizes the module results by license and by category.
+func newHtmlReport(title string, project *ModuleResult, results []ModuleResult) htmlReport {
+	report := htmlReport{Title: title, Project: project, Modules: results}
+	byLicense := make(map[string]*licenseSummary)
+	byCategory := make(map[string]int)
+	for _, r := range results {
+		lic := moduleLicense(r)
+		sum, ok := byLicense[lic]
+		if !ok {
+			sum = &licenseSummary{License: lic, Category: r.Category, Status: r.Status}
+			byLicense[lic] = sum
+		}
+		sum.Modules++
+		if statusRank(r.Status) > statusRank(sum.Status) {
+			sum.Status = r.Status
+		}
+		byCategory[moduleCategory(r)]++
+		report.Statuses = appendUnique(report.Statuses, r.Status)
+	}
+	for _, sum := range byLicense {
+		report.Licenses = append(report.Licenses, *sum)
+	}
+	sort.Slice(report.Licenses, func(i, j int) bool { return report.Licenses[i].License < report.Licenses[j].License })
+	for category, n := range byCategory {
+		report.Categories = append(report.Categories, categorySummary{Category: category, Modules: n})
+	}
+	sort.Slice(report.Categories, func(i, j int) bool {
+		a, b := categoryRank(report.Categories[i].Category), categoryRank(report.Categories[j].Category)
+		if a != b {
+			return a < b
+		}
+		return report.Categories[i].Category < report.Categories[j].Category
+	})
+	sort.Slice(report.Statuses, func(i, j int) bool { return statusRank(report.Statuses[i]) < statusRank(report.Statuses[j]) })
+	return report
+}
+
+// moduleLicense gets the license a module is listed under in the reportHtml.
+func moduleLicense(r ModuleResult) string {
+	if r.Conclusion == "" {
+		return noLicense
+	}
+	return r.Conclusion
+}
+
+// moduleCategory gets the category a module is listed under in the reportHtml.
+func moduleCategory(r ModuleResult) string {
+	if r.Category == "" {
+		return "uncategorized"
+	}
+	return r.Category
+}
+
+// moduleHolders gets the copyright holders of every file of a module.
+func moduleHolders(files []ModuleFile) []string {
+	var holders []string
+	for _, f := range files {
+		for _, c := range f.Copyrights {
+			holders = appendUnique(holders, copyrightHolder(c))
+		}
+	}
+	sort.Strings(holders)
+	return holders
+}
+
+// createHtmlReport creates the reportHtml, a single self-contained page with a summary of the licenses
+// and categories, a search and filters, and a panel for every module.
+func (l *Launch) createHtmlReport() error {
+	var project *ModuleResult
+	if l.Scanner.Project != nil {
+		r := l.Scanner.moduleResult(l.Scanner.Project)
+		project = &r
+	}
+	title := strings.TrimSuffix(l.Scanner.LicFolder, "_Licenses")
+	report := newHtmlReport(title, project, l.Scanner.moduleResults())
+	return l.createReportFile(reportHtml, func(w io.Writer) error {
+		return writeHtmlReport(w, report)
+	})
+}
+
+// writeHtmlReport writes the reportHtml.
+func writeHtmlReport(w io.Writer, report htmlReport) error {
+	funcMap := template.FuncMap{
+		"license":     moduleLicense,
+		"category":    moduleCategory,
+		"holders":     moduleHolders,
+		"lower":       strings.ToLower,
+		"relativeURL": relativeURL,
+		"externalURL": externalURL,
+		"percent": func(f float64) string {
+			return fmt.Sprintf("%.0f%%", f*100)
+		},
+	}
+	layout := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<title>License report{{if .Title}}: {{.Title}}{{end}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+th { background: #f0f0f0; }
+tr.pick { cursor: pointer; }
+tr.pick:hover { background: #f6f6ff; }
+.filters { position: sticky; top: 0; background: #fff; padding: 0.5em 0; border-bottom: 1px solid #ccc; }
+.filters input, .filters select { margin-right: 1em; padding: 0.2em; }
+details.module { border: 1px solid #ddd; margin: 0.3em 0; padding: 0.3em 0.6em; }
+details.module summary { cursor: pointer; }
+.status { font-weight: bold; padding: 0 0.3em; }
+.status-allowed { color: #176117; }
+.status-review { color: #8a5a00; }
+.status-denied { color: #a11; }
+.muted { color: #666; }
+</style>
+</head>
+<body>
+<h1>License report{{if .Title}}: {{.Title}}{{end}}</h1>
+{{with .Project}}<p>Project license: <strong>{{license .}}</strong>{{if .Expression}} <span class="muted">({{.Expression}})</span>{{end}}</p>{{end}}
+<p><a href="index.html">By license</a> | <a href="modules.html">By module</a></p>
+
+<h2>Licenses</h2>
+<table>
+<tr><th>License</th><th>Category</th><th>Status</th><th>Modules</th></tr>
+{{range .Licenses}}<tr class="pick" data-license="{{.License}}"><td>{{.License}}</td><td>{{if .Category}}{{.Category}}{{else}}uncategorized{{end}}</td><td><span class="status status-{{.Status}}">{{.Status}}</span></td><td>{{.Modules}}</td></tr>
+{{end}}</table>
+
+<h2>Categories</h2>
+<table>
+<tr><th>Category</th><th>Modules</th></tr>
+{{range .Categories}}<tr><td>{{.Category}}</td><td>{{.Modules}}</td></tr>
+{{end}}</table>
+
+<h2>Modules</h2>
+<div class="filters">
+<input id="search" type="search" placeholder="Search modules and licenses">
+<select id="license"><option value="">All licenses</option>{{range .Licenses}}<option value="{{.License}}">{{.License}}</option>{{end}}</select>
+<select id="status"><option value="">All statuses</option>{{range .Statuses}}<option value="{{.}}">{{.}}</option>{{end}}</select>
+<span class="muted"><span id="shown">{{len .Modules}}</span> of {{len .Modules}} modules</span>
+</div>
+{{range .Modules}}
+<details class="module" data-search="{{lower .Module}} {{lower (license .)}}" data-license="{{license .}}" data-status="{{.Status}}">
+<summary><strong>{{.Module}}</strong>{{if .Version}} {{.Version}}{{end}}: {{license .}} <span class="status status-{{.Status}}">{{.Status}}</span></summary>
+<p>Category: {{category .}}{{if .Expression}} | Expression: {{.Expression}}{{end}}</p>
+{{if .Obligations}}<p>Obligations: {{range $i, $o := .Obligations}}{{if $i}}, {{end}}{{$o}}{{end}}</p>{{end}}
+{{if .GitLink}}<p>Repo: {{with externalURL .GitLink}}<a href="{{.}}">{{.}}</a>{{else}}{{.GitLink}}{{end}}{{if .GitLicense}} | GitHub declares: <strong>{{.GitLicense}}</strong>{{end}}</p>{{end}}
+{{with holders .Files}}<p>Copyright holders: {{range $i, $h := .}}{{if $i}}; {{end}}{{$h}}{{end}}</p>{{end}}
+{{if .Files}}<table>
+<tr><th>File</th><th>License</th><th>Matched by</th><th>Confidence</th><th>Copyrights</th></tr>
+{{range $file := .Files}}<tr><td>{{with relativeURL $file.Filepath}}<a href="{{.}}">{{$file.Filename}}</a>{{else}}{{$file.Filename}}{{end}}</td><td>{{.License}}</td><td>{{.Classifier}}</td><td>{{if .Confidence}}{{percent .Confidence}}{{end}}</td><td>{{range .Copyrights}}{{.}}<br>{{end}}</td></tr>
+{{end}}</table>{{else}}<p class="muted">No license files found.</p>{{end}}
+</details>
+{{end}}
+<script>
+(function () {
+  var search = document.getElementById("search");
+  var license = document.getElementById("license");
+  var status = document.getElementById("status");
+  var modules = document.querySelectorAll("details.module");
+  function filter() {
+    var q = search.value.toLowerCase();
+    var shown = 0;
+    for (var i = 0; i < modules.length; i++) {
+      var m = modules[i];
+      var ok = (!q || m.getAttribute("data-search").indexOf(q) >= 0) &&
+        (!license.value || m.getAttribute("data-license") === license.value) &&
+        (!status.value || m.getAttribute("data-status") === status.value);
+      m.hidden = !ok;
+      if (ok) {
+        shown++;
+      }
+    }
+    document.getElementById("shown").textContent = shown;
+  }
+  search.addEventListener("input", filter);
+  license.addEventListener("change", filter);
+  status.addEventListener("change", filter);
+  var rows = document.querySelectorAll("tr.pick");
+  for (var i = 0; i < rows.length; i++) {
+    rows[i].addEventListener("click", function () {
+      license.value = this.getAttribute("data-license");
+      filter();
+      search.scrollIntoView();
+    });
+  }
+})();
+</script>
+</body>
+</html>`
+	tmpl := template.Must(template.New("report").Funcs(funcMap).Parse(layout))
+	err := tmpl.Execute(w, report)
+	if err != nil {
+		return fmt.Errorf("error executing html: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package lic
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestHtmlReport(t *testing.T) {
+	project := &ModuleResult{Module: "example.com/project", Conclusion: "MIT License", Status: statusAllowed}
+	results := []ModuleResult{
+		{Module: "example.com/a", Version: "v1.0.0", Conclusion: "MIT License", Status: statusAllowed, Category: "permissive",
+			GitLink: "https://github.com/example/a", GitLicense: "mit",
+			Files: []ModuleFile{{Filename: "example.com/a@v1.0.0/LICENSE", Filepath: "Licenses/abc", License: "MIT License",
+				Copyrights: []string{"Copyright (c) 2020 Jane Doe"}, Classifier: "line", Confidence: 0.93}}},
+		{Module: "example.com/b", Version: "v1.2.0", Conclusion: "MIT License", Status: statusAllowed, Category: "permissive"},
+		{Module: "example.com/c", Version: "v0.1.0", Status: statusDenied},
+	}
+	report := newHtmlReport("repo", project, results)
+	if len(report.Licenses) != 2 || report.Licenses[0].License != "MIT License" || report.Licenses[0].Modules != 2 {
+		t.Fatalf("Unexpected license summary: %+v", report.Licenses)
+	}
+	if len(report.Categories) != 2 || len(report.Statuses) != 2 {
+		t.Fatalf("Unexpected summary: %+v %v", report.Categories, report.Statuses)
+	}
+
+	buf := new(bytes.Buffer)
+	err := writeHtmlReport(buf, report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		`<tr class="pick" data-license="No License">`,
+		`data-search="example.com/a mit license" data-license="MIT License" data-status="allowed"`,
+		`<a href="Licenses/abc">example.com/a@v1.0.0/LICENSE</a>`,
+		"Copyright holders: Jane Doe",
+		"GitHub declares: <strong>mit</strong>",
+		"<td>93%</td>",
+		`<option value="denied">denied</option>`,
+		"Project license: <strong>MIT License</strong>",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in the report:\n%s", want, html)
+		}
+	}
+	for _, external := range []string{"<script src", "<link", "@import", "url("} {
+		if strings.Contains(html, external) {
+			t.Errorf("The report should be self-contained, found %s", external)
+		}
+	}
+}
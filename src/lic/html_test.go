@@ -0,0 +1,82 @@
+package lic
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// hostileInfos are license files with names that try to break out of the html they are rendered into.
+var hostileInfos = []LicenseInfo{
+	{Filename: `example.com/x@v1.0.0/"><script>alert(1)</script>`, Filepath: `Licenses/a"b>c d`,
+		GitLink: `javascript:alert(1)`, GitLicense: `<img src=x onerror=alert(1)>`},
+	{Filename: "github.com/!j!c!price0024/lic-col@v1.0.0/LICENSE", Filepath: "Licenses/github.com/!j!c!price0024/lic-col@v1.0.0/LICENSE",
+		GitLink: `https://github.com/o/r" onmouseover="alert(1)`, Copyrights: []string{"Copyright <b>Evil</b> & Co"}},
+	{Filename: "example.com/y@v1.0.0/LICENSE", Filepath: "../../etc/passwd", GitLink: "//evil.example.com"},
+}
+
+func TestHtmlIndexEscaping(t *testing.T) {
+	out := NewMemSink()
+	l := Launch{Output: out, Scanner: Scanner{LicFolder: "repo_Licenses",
+		LicenseType: map[string][]LicenseInfo{`MIT" onclick="x`: hostileInfos}}}
+	err := l.createHtmlIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	html := string(out.Files()["repo_Licenses/index.html"])
+	for _, want := range []string{
+		`<a href="Licenses/a%22b%3Ec%20d">example.com/x@v1.0.0/&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;</a>`,
+		`<a href="Licenses/github.com/%21j%21c%21price0024/lic-col@v1.0.0/LICENSE">`,
+		`<a href="https://github.com/o/r%22%20onmouseover=%22alert%281%29">Current Repo</a>`,
+		`(github api: &lt;img src=x onerror=alert(1)&gt;)`,
+		`<small>Copyright &lt;b&gt;Evil&lt;/b&gt; &amp; Co</small>`,
+		`<h1>MIT&#34; onclick=&#34;x</h1>`,
+		"<p>example.com/y@v1.0.0/LICENSE\n",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %s in the index:\n%s", want, html)
+		}
+	}
+	for _, bad := range []string{"<script>", "javascript:", "<img", "onmouseover=\"", "etc/passwd", "evil.example.com", "<b>", `href=""`} {
+		if strings.Contains(html, bad) {
+			t.Errorf("Unescaped %s in the index:\n%s", bad, html)
+		}
+	}
+}
+
+func TestHtmlReportEscaping(t *testing.T) {
+	var results []ModuleResult
+	for _, info := range hostileInfos {
+		results = append(results, ModuleResult{Module: info.Filename, Conclusion: `MIT" data-x="`, Status: statusAllowed,
+			GitLink: info.GitLink, GitLicense: info.GitLicense,
+			Files: []ModuleFile{{Filename: info.Filename, Filepath: info.Filepath, Copyrights: info.Copyrights}}})
+	}
+	buf := new(bytes.Buffer)
+	err := writeHtmlReport(buf, newHtmlReport(`"><script>`, nil, results))
+	if err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+	for _, bad := range []string{"<script>alert", `href="javascript:`, "<img", `" onmouseover="`, "etc/passwd", "<b>", `data-x=""`, `href=""`} {
+		if strings.Contains(html, bad) {
+			t.Errorf("Unescaped %s in the report:\n%s", bad, html)
+		}
+	}
+	if !strings.Contains(html, `<a href="Licenses/github.com/%21j%21c%21price0024/lic-col@v1.0.0/LICENSE">`) {
+		t.Errorf("Expected the ! escapes of the module path to be url escaped:\n%s", html)
+	}
+
+	l := Launch{Output: NewMemSink(), Scanner: Scanner{LicFolder: "repo_Licenses"}}
+	for _, info := range hostileInfos {
+		l.Scanner.Modules = map[string]*scannedModule{info.Filename: {Name: info.Filename,
+			Licenses: []scannedLicense{{License: "MIT", Info: info}}}}
+		err = l.createHtmlModules()
+		if err != nil {
+			t.Fatal(err)
+		}
+		modules := string(l.Output.(*MemSink).Files()["repo_Licenses/"+modulesHtml])
+		if strings.Contains(modules, "<script>alert") || strings.Contains(modules, "etc/passwd") || strings.Contains(modules, `href=""`) {
+			t.Errorf("Unescaped file in the modules page:\n%s", modules)
+		}
+	}
+}
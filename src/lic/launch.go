@@ -2,15 +2,18 @@ package lic
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"io/fs"
 	"log"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 )
 
 const filepathErrMsg = "error performing filepath.Walk: %w"
@@ -19,13 +22,35 @@ const filepathErrMsg = "error performing filepath.Walk: %w"
 type Launch struct {
 	Repo             string
 	Dst              string
-	Version          string
+	Version          string // Deprecated: use Ref, Version is only used if Ref is empty.
+	Ref              string // Branch, tag or commit hash to scan.
+	Commit           string // Commit hash that was scanned, resolved from Ref.
+	Worktree         string // Temporary worktree used to scan Ref in an existing clone.
+	GopathClone      bool   // Clone into GOPATH/src and reuse existing clones instead of using a temporary clone.
+	KeepClone        bool   // Keep the temporary clone after the scan.
+	TempClone        string // Temporary directory holding the clone, removed after the scan unless KeepClone is set.
+	ClonedByRun      bool   // True if the GOPATH/src clone was made by this run, only those are removed by CleanupClone.
 	CleanupMod       bool
 	CleanupClone     bool
 	ToHTML           bool
 	GitCheck         bool
 	Gopath           string
 	ModPath          string
+	GoProxy          string // If set modules are fetched from this GOPROXY list instead of using go mod download.
+	GitUser          string // If GitCheck is set and GitUser and GitToken are empty they are read from Standard Input.
+	GitToken         string
+	ModuleCache      map[string]*scannedModule // Shared by every Launch of a Portfolio.
+	Baseline         string                    // Baseline file of accepted findings, if set the scan fails on findings it doesn't cover.
+	ProjectLicense   string                    // Outbound license of the project, detected from the scanned repo if empty.
+	NoSPDXList       bool                      // Only match the licenses of definedlicenses.json, not the embedded SPDX license list.
+	CloneTimeout     time.Duration             // Limit for cloning the repo and checking out the ref, 0 for no limit.
+	DownloadTimeout  time.Duration             // Limit for each go mod download and each module fetched from a GOPROXY.
+	ScanTimeout      time.Duration             // Limit for scanning the dependencies of every go.sum.
+	Partial          bool                      // True if the scan was interrupted, the report only holds the modules scanned before.
+	Output           Sink                      // Where the report is written, the Dst on disk if nil.
+	Archive          string                    // If set the report is written into this reproducible .zip or .tar.gz instead of the Dst.
+	Timestamp        bool                      // Record the time of the scan in the metadataFile, reports hold no wall-clock data without it.
+	LicFolder        string                    // Folder inside the Dst the report is written into, reponame_Licenses if empty.
 	CurrentDownloads map[string]struct{}
 	Scanner          Scanner
 }
@@ -37,19 +62,29 @@ func (l *Launch) initLaunch() error {
 		return errors.New("no GOPATH found")
 	}
 	modpath := filepath.Join(gopath, "pkg", "mod")
-	var token string
-	var user string
 	var err error
-	if l.GitCheck {
-		token, user, err = gitInfo()
+	if l.GitCheck && (l.GitUser == "" || l.GitToken == "") {
+		l.GitToken, l.GitUser, err = gitInfo()
 		if err != nil {
 			return err
 		}
 	}
-	scan, err := initScanner(gopath, modpath, l.Dst, user, token, l.ToHTML)
+	scan, err := initScanner(gopath, modpath, l.Dst, l.GitUser, l.GitToken, l.ToHTML)
 	if err != nil {
 		return err
 	}
+	scan.ModuleCache = l.ModuleCache
+	scan.DownloadTimeout = l.DownloadTimeout
+	scan.Output = l.Output
+	if l.NoSPDXList {
+		scan.Licenses = scan.Licenses.withoutTemplates()
+	}
+	if l.GoProxy != "" {
+		scan.Fetcher, err = newProxyFetcher(l.GoProxy)
+		if err != nil {
+			return err
+		}
+	}
 	l.Gopath = gopath
 	l.ModPath = modpath
 	l.CurrentDownloads = make(map[string]struct{})
@@ -60,38 +95,102 @@ func (l *Launch) initLaunch() error {
 // LaunchProgram is the root of the program. It starts all processes declared by the
 // Command Line Args.
 func (l *Launch) LaunchProgram() error {
+	return l.LaunchProgramContext(context.Background())
+}
+
+// LaunchProgramContext is LaunchProgram with a context. If the context is done or a timeout runs out
+// while the project or its dependencies are scanned, the report of the modules scanned so far is written,
+// marked as partial in the metadataFile, and the error of the context is returned.
+func (l *Launch) LaunchProgramContext(ctx context.Context) error {
+	return withArchive(l.Archive, &l.Output, func() error {
+		return l.launchProgram(ctx)
+	})
+}
+
+// launchProgram clones and scans the repo and writes the report into the Output. If a complete report
+// is written into the Dst, the files of earlier scans that aren't part of it are removed from the LicFolder.
+func (l *Launch) launchProgram(ctx context.Context) error {
 	var err error
+	var dir *dirOutput
+	if l.Output == nil {
+		dir = newDirOutput(l.Dst)
+		l.Output = dir
+		defer func() { l.Output = nil }()
+	}
 	err = l.initLaunch()
 	if err != nil {
 		return err
 	}
+	if l.Ref == "" {
+		l.Ref = l.Version
+	}
 	if l.CleanupMod {
 		filepath.Walk(l.ModPath, l.downloadedWalk)
 	}
 
 	log.Println("Calling CloneRepo")
-	clone, err := l.cloneRepo()
+	cloneCtx, cancel := phaseContext(ctx, l.CloneTimeout)
+	clone, err := l.cloneRepo(cloneCtx)
+	cancel()
+	// The temporary clone and worktree are removed even if the clone failed or was interrupted.
+	if l.TempClone != "" {
+		defer l.removeTempClone()
+	}
+	if l.Worktree != "" {
+		repoDir, _, _ := l.repoPath()
+		defer func() {
+			rmErr := l.removeWorktree(repoDir)
+			if rmErr != nil {
+				log.Println(rmErr)
+			}
+		}()
+	}
 	if err != nil {
 		return err
 	}
 
-	l.Scanner.LicFolder = filepath.Base(clone) + "_" + "Licenses"
+	l.Scanner.LicFolder = l.LicFolder
+	if l.Scanner.LicFolder == "" {
+		l.Scanner.LicFolder = filepath.Base(clone) + "_" + "Licenses"
+	}
 
 	log.Println("CloneRepo completed")
 
 	log.Println("Scanning Cloned Repo")
 
-	l.Scanner.getGitLicense(clone)
+	l.Scanner.getGitLicense(ctx, clone)
+
+	l.Scanner.startProject(clone)
+	scanErr := l.Scanner.walkFS(ctx, os.DirFS(clone), clone)
+	if scanErr == nil {
+		l.Scanner.finishProject()
 
-	err = filepath.Walk(clone, l.Scanner.fileWalk)
+		log.Println("Finished Scanning Cloned Repo")
+
+		scanCtx, cancel := phaseContext(ctx, l.ScanTimeout)
+		scanErr = walkContext(scanCtx, clone, l.sumWalk(scanCtx))
+		cancel()
+	} else if interrupted(scanErr) {
+		// The partly scanned project is dropped like a module, so no project license is reported.
+		l.Scanner.dropModule()
+		l.Scanner.Project = nil
+	}
+	if scanErr != nil {
+		if !interrupted(scanErr) {
+			return scanErr
+		}
+		log.Println("Scan interrupted, writing the report of the modules scanned so far: ", scanErr)
+		l.Partial = true
+		err = l.Scanner.createScanFiles()
+		if err != nil {
+			return err
+		}
+	}
+	err = l.checkCompatibility()
 	if err != nil {
 		return err
 	}
-
-	log.Println("Finished Scanning Cloned Repo")
-
-	l.Scanner.GitLicense = ""
-	err = filepath.Walk(clone, l.sumWalk)
+	err = l.createMetadataFile()
 	if err != nil {
 		return err
 	}
@@ -103,7 +202,7 @@ func (l *Launch) LaunchProgram() error {
 		}
 		log.Println("Cleaning Complete")
 	}
-	if l.CleanupClone {
+	if l.CleanupClone && l.ClonedByRun {
 		log.Println("Cleaning Clone")
 		err = os.RemoveAll(clone)
 		if err != nil {
@@ -118,11 +217,71 @@ func (l *Launch) LaunchProgram() error {
 		if err != nil {
 			return err
 		}
+		err = l.createHtmlModules()
+		if err != nil {
+			return err
+		}
+		err = l.createHtmlReport()
+		if err != nil {
+			return err
+		}
+	}
+	if l.Baseline != "" && !l.Partial {
+		log.Println("Checking baseline")
+		err = l.checkBaseline()
+		if err != nil && !errors.Is(err, ErrNewFindings) {
+			return err
+		}
+	}
+	// A partial report doesn't replace the copies of the last complete scan, so nothing is pruned.
+	if dir != nil && !l.Partial {
+		pruneErr := dir.prune(l.Scanner.LicFolder)
+		if pruneErr != nil {
+			return pruneErr
+		}
+	}
+	if l.Partial {
+		return fmt.Errorf("scan interrupted, the report is partial: %w", scanErr)
+	}
+	if err != nil {
+		return err
 	}
 	log.Println("Exiting")
 	return nil
 }
 
+// phaseContext gets the context of a phase of the scan, limited to timeout if it isn't 0.
+func phaseContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// interrupted is true if the error comes from a context that was canceled or ran out of time.
+func interrupted(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// contextErr gets the error of the context if it is done, otherwise err. A command killed by its
+// context only reports the signal it was killed with.
+func contextErr(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// walkContext is filepath.Walk that stops once the context is done.
+func walkContext(ctx context.Context, root string, fn filepath.WalkFunc) error {
+	return filepath.Walk(root, func(path string, info fs.FileInfo, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return fn(path, info, err)
+	})
+}
+
 // gitInfo safely gets the Git Username and Personal Access Token from Standard Input.
 func gitInfo() (string, string, error) {
 	var potentialUsername *bufio.Reader = bufio.NewReader(os.Stdin)
@@ -141,60 +300,255 @@ func gitInfo() (string, string, error) {
 	return token, Username, nil
 }
 
-// cloneRepo performs a git clone on the provided repo. If there is a version tag
-// cloneRepo also performs a git checkout on that version.
-func (l *Launch) cloneRepo() (string, error) {
-	repoDir := ""
-	repoBase := ""
-	if strings.Contains(l.Repo, "https://") {
-		parts := strings.Split(l.Repo, "/")
-		path := []string{l.Gopath, "src"}
-		path = append(path, parts[1:len(parts)-1]...)
-		repoBase = filepath.Join(path...)
-		repo := parts[len(parts)-1]
-		extension := filepath.Ext(repo)
-		repoDir = filepath.Join(repoBase, repo[0:len(repo)-len(extension)])
-	} else if strings.Contains(l.Repo, "git@") {
-		ssh := strings.ReplaceAll(l.Repo, "git@", "")
-		sshPath := strings.Replace(ssh, ":", "/", 1)
-		parts := strings.Split(sshPath, "/")
-		path := []string{l.Gopath, "src"}
-		path = append(path, parts[:len(parts)-1]...)
-		repoBase = filepath.Join(path...)
-		repo := parts[len(parts)-1]
-		extension := filepath.Ext(repo)
-		repoDir = filepath.Join(repoBase, repo[0:len(repo)-len(extension)])
+// repoPath gets the path the repo has in GOPATH/src and its name in the form host/owner/reponame.
+func (l *Launch) repoPath() (repoDir string, repoBase string, name string) {
+	var parts []string
+	repoURL := strings.TrimRight(l.Repo, "/")
+	if strings.Contains(repoURL, "https://") {
+		parts = strings.Split(strings.TrimPrefix(repoURL, "https://"), "/")
+	} else if strings.HasPrefix(repoURL, "file://") {
+		parts = strings.Split(strings.Trim(strings.TrimPrefix(repoURL, "file://"), "/"), "/")
+	} else if strings.Contains(repoURL, "git@") {
+		ssh := strings.ReplaceAll(repoURL, "git@", "")
+		parts = strings.Split(strings.Replace(ssh, ":", "/", 1), "/")
+	}
+	if len(parts) == 0 {
+		return "", "", ""
+	}
+	path := []string{l.Gopath, "src"}
+	path = append(path, parts[:len(parts)-1]...)
+	repoBase = filepath.Join(path...)
+	repo := parts[len(parts)-1]
+	extension := filepath.Ext(repo)
+	repo = repo[0 : len(repo)-len(extension)]
+	repoDir = filepath.Join(repoBase, repo)
+	name = strings.Join(append(parts[:len(parts)-1:len(parts)-1], repo), "/")
+	return repoDir, repoBase, name
+}
+
+// sparsePatterns are the files checked out by a temporary clone. Only the files needed to find the
+// dependencies and the top level license files of the repo are downloaded.
+var sparsePatterns = []string{
+	"go.mod",
+	"go.sum",
+	"go.work",
+	"go.work.sum",
+	"vendor/modules.txt",
+	"/*[Ll][Ii][Cc][Ee][Nn][Ss][Ee]*",
+	"/*[Cc][Oo][Pp][Yy][Ii][Nn][Gg]*",
+	"/*[Nn][Oo][Tt][Ii][Cc][Ee]*",
+}
+
+// cloneRepo clones the provided repo. By default the clone is a shallow, sparse clone in a temporary
+// directory, if GopathClone is set it is a full clone in GOPATH/src.
+func (l *Launch) cloneRepo(ctx context.Context) (string, error) {
+	if !l.GopathClone {
+		return l.tempClone(ctx)
+	}
+	return l.gopathClone(ctx)
+}
+
+// tempClone performs a shallow, sparse clone of the ref (or the default branch if there is no ref)
+// into a temporary directory. Only the files matching the sparsePatterns and the included files
+// are checked out.
+func (l *Launch) tempClone(ctx context.Context) (string, error) {
+	_, _, name := l.repoPath()
+	// The clone is named after the repo, it is also the name of the report folder.
+	base := path.Base(name)
+	if name == "" || base == "." || base == ".." || base == "/" {
+		return "", fmt.Errorf("error cloning repo: can't get the repo name from %q", l.Repo)
+	}
+	tmp, err := os.MkdirTemp("", "lic-col-clone-")
+	if err != nil {
+		return "", fmt.Errorf("error making clone directory: %w", err)
+	}
+	l.TempClone = tmp
+	repoDir := filepath.Join(tmp, base)
+	l.Scanner.CloneDir = repoDir
+	l.Scanner.CloneName = name
+
+	patterns := append([]string{}, sparsePatterns...)
+	for incl := range l.Scanner.Inclusions {
+		patterns = append(patterns, "/"+incl)
+	}
+	ref := l.Ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	log.Println("Calling git clone into: ", repoDir)
+	err = runGitCommands(ctx, repoDir,
+		[]string{"init", "-q", repoDir},
+		[]string{"remote", "add", "origin", l.Repo},
+		[]string{"config", "core.sparseCheckout", "true"},
+	)
+	if err != nil {
+		return "", err
+	}
+	err = os.MkdirAll(filepath.Join(repoDir, ".git", "info"), os.ModePerm)
+	if err != nil {
+		return "", fmt.Errorf("error making sparse-checkout directory: %w", err)
+	}
+	err = os.WriteFile(filepath.Join(repoDir, ".git", "info", "sparse-checkout"), []byte(strings.Join(patterns, "\n")+"\n"), 0666)
+	if err != nil {
+		return "", fmt.Errorf("error writing sparse-checkout: %w", err)
+	}
+	err = runGitCommands(ctx, repoDir, []string{"fetch", "-q", "--depth", "1", "--filter=blob:none", "origin", ref})
+	if err != nil {
+		// Some servers don't allow fetching a commit hash directly, fall back to fetching
+		// every branch and tag.
+		log.Println("Shallow fetch failed, fetching all refs: ", err)
+		err = runGitCommands(ctx, repoDir, []string{"fetch", "-q", "--tags", "--filter=blob:none", "origin", "+refs/heads/*:refs/remotes/origin/*"})
+		if err != nil {
+			return "", err
+		}
+		err = runGitCommands(ctx, repoDir, []string{"checkout", "-q", ref})
+	} else {
+		err = runGitCommands(ctx, repoDir, []string{"checkout", "-q", "FETCH_HEAD"})
+	}
+	if err != nil {
+		return "", err
+	}
+	log.Println("Clone completed")
+	return repoDir, l.resolveCommit(ctx, repoDir)
+}
+
+// removeTempClone removes the temporary clone unless KeepClone is set.
+func (l *Launch) removeTempClone() {
+	if l.KeepClone {
+		log.Println("Keeping clone: ", l.Scanner.CloneDir)
+		return
+	}
+	err := os.RemoveAll(l.TempClone)
+	if err != nil {
+		log.Printf("error removing clone: clone: %s err: %v", l.TempClone, err)
+		return
 	}
+	l.TempClone = ""
+}
+
+// runGitCommands runs each git command in dir and stops at the first error.
+func runGitCommands(ctx context.Context, dir string, cmds ...[]string) error {
+	for _, args := range cmds {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		if args[0] != "init" {
+			cmd.Dir = dir
+		}
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("error running git %s command: %w: %s", args[0], contextErr(ctx, err), strings.TrimSpace(string(out)))
+		}
+	}
+	return nil
+}
+
+// gopathClone performs a git clone on the provided repo into GOPATH/src. If there is a ref (branch, tag or commit hash)
+// gopathClone also performs a git checkout on that ref. If the repo was already cloned the ref is checked
+// out into a temporary git worktree so the existing working tree is left untouched.
+func (l *Launch) gopathClone(ctx context.Context) (string, error) {
+	repoDir, repoBase, name := l.repoPath()
+	l.Scanner.CloneName = name
 	log.Println("Calling Stat on: ", repoDir)
 	_, err := os.Stat(repoDir)
 	if err == nil {
-		log.Println("repo already exists, analyzing current version.")
-		return repoDir, nil
+		if l.Ref == "" {
+			log.Println("repo already exists, analyzing current version.")
+			return repoDir, l.resolveCommit(ctx, repoDir)
+		}
+		log.Println("repo already exists, creating worktree for: ", l.Ref)
+		return l.addWorktree(ctx, repoDir)
 	}
 	err = os.MkdirAll(repoBase, os.ModePerm)
 	if err != nil {
 		return "", fmt.Errorf("error making directory: %w", err)
 	}
-	cloneRepo := exec.Command("git", "clone", l.Repo)
+	cloneRepo := exec.CommandContext(ctx, "git", "clone", l.Repo)
 	cloneRepo.Dir = repoBase
 	log.Println("Calling git clone")
 	err = cloneRepo.Run()
 	if err != nil {
-		return "", fmt.Errorf("error running git clone command: %w", err)
+		// A killed git clone can leave a partial clone behind, it would be reused by the next run.
+		os.RemoveAll(repoDir)
+		return "", fmt.Errorf("error running git clone command: %w", contextErr(ctx, err))
 	}
+	l.ClonedByRun = true
 	log.Println("Clone completed")
 
-	if l.Version != "" {
-		gitCheckout := exec.Command("git", "checkout", l.Version)
+	if l.Ref != "" {
+		gitCheckout := exec.CommandContext(ctx, "git", "checkout", l.Ref)
 		gitCheckout.Dir = repoDir
 		log.Println("Calling git checkout")
 		err = gitCheckout.Run()
 		if err != nil {
-			return "", fmt.Errorf("error running git checkout command: %w", err)
+			return "", fmt.Errorf("error running git checkout command: %w", contextErr(ctx, err))
 		}
 		log.Println("Checkout completed")
 	}
-	return repoDir, nil
+	return repoDir, l.resolveCommit(ctx, repoDir)
+}
+
+// addWorktree checks out the ref of an existing clone into a temporary git worktree. If the ref
+// isn't known to the clone it is fetched from origin first.
+func (l *Launch) addWorktree(ctx context.Context, repoDir string) (string, error) {
+	ref := l.Ref
+	verify := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	verify.Dir = repoDir
+	if verify.Run() != nil {
+		log.Println("Ref not found locally, calling git fetch")
+		fetch := exec.CommandContext(ctx, "git", "fetch", "origin", ref)
+		fetch.Dir = repoDir
+		out, err := fetch.CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("error running git fetch command: %w: %s", contextErr(ctx, err), out)
+		}
+		ref = "FETCH_HEAD"
+	}
+	tmp, err := os.MkdirTemp("", "lic-col-worktree-")
+	if err != nil {
+		return "", fmt.Errorf("error making worktree directory: %w", err)
+	}
+	worktree := filepath.Join(tmp, filepath.Base(repoDir))
+	add := exec.CommandContext(ctx, "git", "worktree", "add", "--detach", worktree, ref)
+	add.Dir = repoDir
+	out, err := add.CombinedOutput()
+	if err != nil {
+		os.RemoveAll(tmp)
+		return "", fmt.Errorf("error running git worktree add command: %w: %s", contextErr(ctx, err), out)
+	}
+	l.Worktree = worktree
+	l.Scanner.CloneDir = worktree
+	return worktree, l.resolveCommit(ctx, worktree)
+}
+
+// removeWorktree removes the temporary worktree made by addWorktree.
+func (l *Launch) removeWorktree(repoDir string) error {
+	if l.Worktree == "" {
+		return nil
+	}
+	remove := exec.Command("git", "worktree", "remove", "--force", l.Worktree)
+	remove.Dir = repoDir
+	out, err := remove.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error running git worktree remove command: %w: %s", err, out)
+	}
+	err = os.RemoveAll(filepath.Dir(l.Worktree))
+	if err != nil {
+		return fmt.Errorf("error removing worktree: worktree: %s err: %w", l.Worktree, err)
+	}
+	l.Worktree = ""
+	return nil
+}
+
+// resolveCommit stores the commit hash that is checked out in dir.
+func (l *Launch) resolveCommit(ctx context.Context, dir string) error {
+	revParse := exec.CommandContext(ctx, "git", "rev-parse", "HEAD")
+	revParse.Dir = dir
+	out, err := revParse.Output()
+	if err != nil {
+		return fmt.Errorf("error running git rev-parse command: %w", err)
+	}
+	l.Commit = strings.TrimSpace(string(out))
+	log.Println("Scanning commit: ", l.Commit)
+	return nil
 }
 
 // cleanerWalk performs a filepath.Walk on the modpath at the end of the program and deletes all
@@ -239,29 +593,36 @@ func (l *Launch) downloadedWalk(path string, info fs.FileInfo, err error) error
 	return nil
 }
 
-// sumWalk performs a filepath.Walk on the provided repo and finds all go.sum files in the repo
-// for scanning. It also performs the go mod download on the repo.
-func (l *Launch) sumWalk(path string, info fs.FileInfo, err error) error {
-	if err != nil {
-		return fmt.Errorf(filepathErrMsg, err)
-	}
-	if info.Name() != "go.sum" {
-		return nil
-	}
+// sumWalk gets the filepath.WalkFunc that finds all go.sum files in the repo for scanning. It also
+// performs the go mod download on the repo unless a GOPROXY is used, in which case the modules are
+// fetched by the Scanner.
+func (l *Launch) sumWalk(ctx context.Context) filepath.WalkFunc {
+	return func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf(filepathErrMsg, err)
+		}
+		if info.Name() != "go.sum" {
+			return nil
+		}
 
-	log.Println("Downloading sum data: ", path)
-	goModDownload := exec.Command("go", "mod", "download")
-	goModDownload.Dir = filepath.Dir(path)
-	err = goModDownload.Run()
-	if err != nil {
-		return fmt.Errorf("error running go mod download files: %w", err)
-	}
-	log.Println("Download completed")
-	sum, err := os.ReadFile(path)
-	if err != nil {
-		return fmt.Errorf("error opening mod file: sum:%s err:%w", sum, err)
+		if l.GoProxy == "" {
+			log.Println("Downloading sum data: ", path)
+			downloadCtx, cancel := phaseContext(ctx, l.DownloadTimeout)
+			defer cancel()
+			goModDownload := exec.CommandContext(downloadCtx, "go", "mod", "download")
+			goModDownload.Dir = filepath.Dir(path)
+			err = goModDownload.Run()
+			if err != nil {
+				return fmt.Errorf("error running go mod download files: %w", contextErr(downloadCtx, err))
+			}
+			log.Println("Download completed")
+		}
+		sum, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error opening mod file: sum:%s err:%w", sum, err)
+		}
+		l.Scanner.ProjectSum = string(sum)
+		log.Println("Starting Sum Scan")
+		return l.Scanner.ScanPathContext(ctx)
 	}
-	l.Scanner.ProjectSum = string(sum)
-	log.Println("Starting Sum Scan")
-	return l.Scanner.ScanPath()
 }
@@ -0,0 +1,326 @@
+package lic
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// runGit runs a git command in dir and returns its trimmed output.
+func runGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v: %s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestCloneRepoWorktree(t *testing.T) {
+	gopath := testGopath(t)
+	repoDir := filepath.Join(gopath, "src", "github.com", "owner", "repo")
+	os.MkdirAll(repoDir, os.ModePerm)
+	runGit(t, repoDir, "init", "-q")
+	os.WriteFile(filepath.Join(repoDir, "LICENSE"), []byte("first"), os.ModePerm)
+	runGit(t, repoDir, "add", "-A")
+	runGit(t, repoDir, "commit", "-q", "-m", "first")
+	runGit(t, repoDir, "tag", "v1")
+	tagged := runGit(t, repoDir, "rev-parse", "HEAD")
+	os.WriteFile(filepath.Join(repoDir, "LICENSE"), []byte("second"), os.ModePerm)
+	runGit(t, repoDir, "commit", "-q", "-am", "second")
+
+	launcher := Launch{Repo: "https://github.com/owner/repo.git", Ref: "v1", Gopath: gopath, GopathClone: true}
+	clone, err := launcher.cloneRepo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clone == repoDir || launcher.Commit != tagged {
+		t.Fatalf("Expected worktree at %s GOT: %s commit: %s", tagged, clone, launcher.Commit)
+	}
+	bs, _ := os.ReadFile(filepath.Join(clone, "LICENSE"))
+	if string(bs) != "first" {
+		t.Fatalf("Expected tagged LICENSE GOT: %s", bs)
+	}
+	if launcher.Scanner.cleanPath(filepath.Join(clone, "LICENSE"), false) != filepath.Join("github.com", "owner", "repo", "LICENSE") {
+		t.Fatalf("Unexpected clean path: %s", launcher.Scanner.cleanPath(filepath.Join(clone, "LICENSE"), false))
+	}
+	err = launcher.removeWorktree(repoDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, _ = os.ReadFile(filepath.Join(repoDir, "LICENSE"))
+	if string(bs) != "second" {
+		t.Fatalf("Existing working tree was changed: %s", bs)
+	}
+	if _, err = os.Stat(clone); !os.IsNotExist(err) {
+		t.Fatalf("Expected worktree to be removed: %v", err)
+	}
+}
+
+func TestCloneRepoTemp(t *testing.T) {
+	gopath := testGopath(t)
+	origin := filepath.Join(t.TempDir(), "owner", "repo")
+	os.MkdirAll(filepath.Join(origin, "sub", "src"), os.ModePerm)
+	runGit(t, origin, "init", "-q")
+	runGit(t, origin, "config", "uploadpack.allowFilter", "true")
+	runGit(t, origin, "config", "uploadpack.allowAnySHA1InWant", "true")
+	files := map[string]string{
+		"LICENSE.md":         "license",
+		"go.mod":             "module example.com/repo",
+		"main.go":            "package main",
+		"sub/go.sum":         "",
+		"sub/src/LICENSE.md": "nested license",
+	}
+	for name, data := range files {
+		os.WriteFile(filepath.Join(origin, filepath.FromSlash(name)), []byte(data), os.ModePerm)
+	}
+	runGit(t, origin, "add", "-A")
+	runGit(t, origin, "commit", "-q", "-m", "first")
+	head := runGit(t, origin, "rev-parse", "HEAD")
+	runGit(t, origin, "commit", "-q", "--allow-empty", "-m", "second")
+
+	launcher := Launch{Repo: "file://" + filepath.ToSlash(origin), Ref: head, Gopath: gopath}
+	clone, err := launcher.cloneRepo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(clone, launcher.TempClone) || launcher.Commit != head {
+		t.Fatalf("Unexpected clone: %s commit: %s", clone, launcher.Commit)
+	}
+	for name := range files {
+		_, err = os.Stat(filepath.Join(clone, filepath.FromSlash(name)))
+		expected := name != "main.go" && name != "sub/src/LICENSE.md"
+		if (err == nil) != expected {
+			t.Fatalf("Unexpected sparse checkout of %s: %v", name, err)
+		}
+	}
+	tmp := launcher.TempClone
+	launcher.removeTempClone()
+	if _, err = os.Stat(tmp); !os.IsNotExist(err) {
+		t.Fatalf("Expected clone to be removed: %v", err)
+	}
+}
+
+func TestCloneRepoTempName(t *testing.T) {
+	for _, repo := range []string{"", "https://", "file:///", "ssh://example.com/owner/repo"} {
+		launcher := Launch{Repo: repo}
+		_, err := launcher.tempClone(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "can't get the repo name") || launcher.TempClone != "" {
+			t.Errorf("Expected %q to be rejected before cloning, got: %v", repo, err)
+		}
+	}
+	launcher := Launch{Repo: "https://github.com/owner/repo.git/"}
+	if _, _, name := launcher.repoPath(); name != "github.com/owner/repo" {
+		t.Errorf("Unexpected repo name: %s", name)
+	}
+}
+
+func TestLaunchPartialReport(t *testing.T) {
+	gopath := testGopath(t)
+	files := newTestProxy(t)
+	files.add("example.com/fast", "v1.0.0", map[string]string{"LICENSE": testMIT})
+	files.add("example.com/slow", "v1.0.0", map[string]string{"LICENSE": testMIT})
+	server := http.FileServer(http.Dir(files.Dir))
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/example.com/slow/") {
+			<-r.Context().Done()
+			return
+		}
+		server.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	origin := filepath.Join(t.TempDir(), "owner", "repo")
+	os.MkdirAll(origin, os.ModePerm)
+	runGit(t, origin, "init", "-q")
+	os.WriteFile(filepath.Join(origin, "go.sum"), []byte(files.Sum), os.ModePerm)
+	runGit(t, origin, "add", "-A")
+	runGit(t, origin, "commit", "-q", "-m", "first")
+
+	dst := t.TempDir()
+	// A copy of an earlier complete scan, a partial scan must not prune it.
+	earlier := filepath.Join(dst, "repo_Licenses", "Licenses", licenseHash([]byte(testZlib)))
+	os.MkdirAll(filepath.Dir(earlier), os.ModePerm)
+	os.WriteFile(earlier, []byte(testZlib), os.ModePerm)
+	launcher := Launch{Repo: "file://" + filepath.ToSlash(origin), Dst: dst, Gopath: gopath, GoProxy: proxy.URL,
+		DownloadTimeout: 200 * time.Millisecond}
+	err := launcher.LaunchProgramContext(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) || !launcher.Partial {
+		t.Fatalf("Expected the download timeout to interrupt the scan: %v", err)
+	}
+	folder := filepath.Join(dst, "repo_Licenses")
+	var meta scanMetadata
+	bs, _ := os.ReadFile(filepath.Join(folder, metadataFile))
+	if json.Unmarshal(bs, &meta) != nil || !meta.Partial {
+		t.Errorf("Expected the metadata to be marked partial: %s", bs)
+	}
+	var results []ModuleResult
+	bs, _ = os.ReadFile(filepath.Join(folder, modulesFile))
+	if json.Unmarshal(bs, &results) != nil || len(results) != 1 || results[0].Module != "example.com/fast" {
+		t.Errorf("Expected only the scanned module in the report: %s", bs)
+	}
+	if len(launcher.Scanner.Modules) != 1 {
+		t.Errorf("The interrupted module should be dropped: %v", launcher.Scanner.Modules)
+	}
+	if _, err := os.Stat(earlier); err != nil {
+		t.Errorf("A partial scan shouldn't prune the copies of an earlier scan: %v", err)
+	}
+}
+
+// projectCancel is a context that is canceled once a license of the project has been found.
+type projectCancel struct {
+	context.Context
+	l        *Launch
+	canceled bool
+}
+
+func (c *projectCancel) Err() error {
+	if c.l.Scanner.Project != nil && len(c.l.Scanner.LicenseType) > 0 {
+		c.canceled = true
+	}
+	if c.canceled {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestLaunchProjectInterrupted(t *testing.T) {
+	gopath := testGopath(t)
+	proxy := newTestProxy(t)
+	proxy.add("example.com/mit", "v1.0.0", map[string]string{"LICENSE": testMIT})
+
+	origin := filepath.Join(t.TempDir(), "owner", "repo")
+	os.MkdirAll(origin, os.ModePerm)
+	runGit(t, origin, "init", "-q")
+	os.WriteFile(filepath.Join(origin, "LICENSE"), []byte(testZlib), os.ModePerm)
+	os.WriteFile(filepath.Join(origin, "go.sum"), []byte(proxy.Sum), os.ModePerm)
+	runGit(t, origin, "add", "-A")
+	runGit(t, origin, "commit", "-q", "-m", "first")
+
+	dst := t.TempDir()
+	launcher := Launch{Repo: "file://" + filepath.ToSlash(origin), Dst: dst, Gopath: gopath, GoProxy: proxy.URL()}
+	err := launcher.LaunchProgramContext(&projectCancel{Context: context.Background(), l: &launcher})
+	if !errors.Is(err, context.Canceled) || !launcher.Partial {
+		t.Fatalf("Expected the cancel to interrupt the scan of the project: %v", err)
+	}
+	folder := filepath.Join(dst, "repo_Licenses")
+	var meta scanMetadata
+	bs, _ := os.ReadFile(filepath.Join(folder, metadataFile))
+	if json.Unmarshal(bs, &meta) != nil || !meta.Partial {
+		t.Errorf("Expected the metadata to be marked partial: %s", bs)
+	}
+	types := make(map[string][]LicenseInfo)
+	bs, _ = os.ReadFile(filepath.Join(folder, licTypesFile))
+	if json.Unmarshal(bs, &types) != nil || len(types) != 0 {
+		t.Errorf("Expected the licenses of the partly scanned project to be dropped: %s", bs)
+	}
+	if launcher.Scanner.Project != nil || len(launcher.Scanner.Modules) != 0 {
+		t.Errorf("Expected no project or modules: %+v %v", launcher.Scanner.Project, launcher.Scanner.Modules)
+	}
+}
+
+func TestLaunchArchive(t *testing.T) {
+	gopath := testGopath(t)
+	proxy := newTestProxy(t)
+	proxy.add("example.com/mit", "v1.0.0", map[string]string{"LICENSE": testMIT})
+	proxy.add("example.com/zlib", "v1.0.0", map[string]string{"LICENSE": testZlib, "NOTICE": "Copyright (c) 2020 Zlib Authors"})
+
+	origin := filepath.Join(t.TempDir(), "owner", "repo")
+	os.MkdirAll(origin, os.ModePerm)
+	runGit(t, origin, "init", "-q")
+	os.WriteFile(filepath.Join(origin, "go.sum"), []byte(proxy.Sum), os.ModePerm)
+	runGit(t, origin, "add", "-A")
+	runGit(t, origin, "commit", "-q", "-m", "first")
+
+	var archives [][]byte
+	for i := 0; i < 2; i++ {
+		archive := filepath.Join(t.TempDir(), "licenses.zip")
+		launcher := Launch{Repo: "file://" + filepath.ToSlash(origin), Gopath: gopath,
+			GoProxy: proxy.URL(), ToHTML: true, Archive: archive}
+		err := launcher.LaunchProgram()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bs, err := os.ReadFile(archive)
+		if err != nil {
+			t.Fatal(err)
+		}
+		archives = append(archives, bs)
+	}
+	if !bytes.Equal(archives[0], archives[1]) {
+		t.Fatal("Two scans of the same commit should write byte-identical archives")
+	}
+	zr, err := zip.NewReader(bytes.NewReader(archives[0]), int64(len(archives[0])))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sums, err := fs.ReadFile(zr, manifestFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"repo_Licenses/" + licTypesFile, "repo_Licenses/index.html", "repo_Licenses/" + reportHtml, "repo_Licenses/" + metadataFile} {
+		if !strings.Contains(string(sums), "  "+name+"\n") {
+			t.Errorf("Expected %s in the manifest:\n%s", name, sums)
+		}
+	}
+}
+
+func TestLaunchOutputNames(t *testing.T) {
+	gopath := testGopath(t)
+	proxy := newTestProxy(t)
+	proxy.add("example.com/a/b", "v1.0.0", map[string]string{"LICENSE": testMIT})
+	proxy.add("example.com/a_b", "v1.0.0", map[string]string{"LICENSE": testZlib})
+	proxy.add("example.com/c", "v1.0.0", map[string]string{"LICENSE": testMIT, "sub/LICENSE": testMIT})
+
+	origin := filepath.Join(t.TempDir(), "owner", "repo")
+	os.MkdirAll(origin, os.ModePerm)
+	runGit(t, origin, "init", "-q")
+	os.WriteFile(filepath.Join(origin, "go.sum"), []byte(proxy.Sum), os.ModePerm)
+	runGit(t, origin, "add", "-A")
+	runGit(t, origin, "commit", "-q", "-m", "first")
+
+	dst := t.TempDir()
+	licenses := filepath.Join(dst, "repo_Licenses", "Licenses")
+	stale := filepath.Join(licenses, "example.com", "gone@v1.0.0", "LICENSE")
+	os.MkdirAll(filepath.Dir(stale), os.ModePerm)
+	os.WriteFile(stale, []byte("old"), os.ModePerm)
+	mitCopy := filepath.Join(licenses, licenseHash([]byte(testMIT)))
+	os.WriteFile(mitCopy, []byte(testMIT+testMIT), os.ModePerm)
+
+	launcher := Launch{Repo: "file://" + filepath.ToSlash(origin), Dst: dst, Gopath: gopath, GoProxy: proxy.URL()}
+	err := launcher.LaunchProgram()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{mitCopy: testMIT, filepath.Join(licenses, licenseHash([]byte(testZlib))): testZlib} {
+		bs, err := os.ReadFile(name)
+		if err != nil || string(bs) != want {
+			t.Errorf("Unexpected copy %s: %q %v", name, bs, err)
+		}
+	}
+	if entries, _ := os.ReadDir(licenses); len(entries) != 2 {
+		t.Errorf("Expected one copy of each text, the copies of an earlier scan should be removed: %v", entries)
+	}
+	var texts map[string]licenseText
+	bs, _ := os.ReadFile(filepath.Join(dst, "repo_Licenses", textsFile))
+	if err := json.Unmarshal(bs, &texts); err != nil {
+		t.Fatal(err)
+	}
+	mit := texts[licenseHash([]byte(testMIT))]
+	if mit.Files != 3 || !reflect.DeepEqual(mit.Modules, []string{"example.com/a/b@v1.0.0", "example.com/c@v1.0.0"}) {
+		t.Errorf("Unexpected license text: %+v", mit)
+	}
+}
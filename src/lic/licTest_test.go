@@ -1,12 +1,15 @@
 package lic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"os"
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestLicTestRepoHtml(t *testing.T) {
@@ -28,7 +31,7 @@ func TestLicTestRepoHtml(t *testing.T) {
 		log.Println(err)
 		t.Fatalf("FAILED SCAN: %v", err)
 	}
-	expected := map[string][]licenseInfo{}
+	expected := map[string][]LicenseInfo{}
 	exp, err := os.Open(filepath.Join("c:", string(filepath.Separator), "Users", "coold", "go", "src", "github.com", "JCPrice0024", "lic-col", "Config", "expectedresultshtml.json"))
 	if err != nil {
 		log.Println(err)
@@ -71,7 +74,7 @@ func TestLicTestRepo(t *testing.T) {
 		log.Println(err)
 		t.Fatalf("FAILED SCAN: %v", err)
 	}
-	expected := map[string][]licenseInfo{}
+	expected := map[string][]LicenseInfo{}
 	exp, err := os.Open(filepath.Join("c:", string(filepath.Separator), "Users", "coold", "go", "src", "github.com", "JCPrice0024", "lic-col", "Config", "expectedresults.json"))
 	if err != nil {
 		log.Println(err)
@@ -134,12 +137,12 @@ func TestGithubApiPull(t *testing.T) {
 		CompletedApiCheck: make(completedApiCheck),
 		GitLicense:        "",
 	}
-	err := scan.getGitLicense(filepath.Join(os.Getenv("GOPATH"), "src", "github.com", "JCPrice0024", "lic-testRepo5"))
+	err := scan.getGitLicense(context.Background(), filepath.Join(os.Getenv("GOPATH"), "src", "github.com", "JCPrice0024", "lic-testRepo5"))
 	if err != nil {
 		t.Fatalf("Failed to get Git License: %v", err)
 	}
 
-	err = scan.getGitLicense(filepath.Join(os.Getenv("GOPATH"), "src", "github.com", "JCPrice0024", "lic-testRepo5", "src"))
+	err = scan.getGitLicense(context.Background(), filepath.Join(os.Getenv("GOPATH"), "src", "github.com", "JCPrice0024", "lic-testRepo5", "src"))
 	if err != nil {
 		t.Fatalf("Failed to get Git License a 2nd time: %v", err)
 	}
@@ -147,11 +150,22 @@ func TestGithubApiPull(t *testing.T) {
 	gitApi := repo{
 		Remaining: 50,
 	}
-	if gitApi.calcGitApiSleep() {
+	if nearLimit, _ := gitApi.calcGitApiSleep(context.Background()); nearLimit {
 		t.Fatal("Expected to be near limit")
 	}
 	gitApi.Remaining = 1500
-	if gitApi.calcGitApiSleep() {
+	if nearLimit, _ := gitApi.calcGitApiSleep(context.Background()); nearLimit {
 		t.Fatal("Expected not to be near limit")
 	}
 }
+
+func TestGitApiSleepCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	gitApi := repo{Limit: 5000, Remaining: 2000}
+	start := time.Now()
+	_, err := gitApi.calcGitApiSleep(ctx)
+	if !errors.Is(err, context.Canceled) || time.Since(start) > time.Second {
+		t.Fatalf("Expected the rate limit wait to stop once the context is canceled: %v after %v", err, time.Since(start))
+	}
+}
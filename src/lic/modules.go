@@ -0,0 +1,277 @@
+package lic
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// modulesFile is the json file in the LicFolder that holds the result of every module.
+const modulesFile = "modules.json"
+
+// modulesHtml is the html version of the modulesFile, it is made if -tohtml is used.
+const modulesHtml = "modules.html"
+
+// licenseVariant matches license files named after the license they hold (example: LICENSE-MIT,
+// LICENSE.APACHE, COPYING-GPL). A module with several of them at its root is dual-licensed.
+var licenseVariant = regexp.MustCompile(`(?i)^(licen[cs]e|copying)[-_.]([a-z0-9][a-z0-9.\-]*?)(\.(txt|md))?$`)
+
+// ModuleResult is the result of scanning a single module. It lists every license file found in the
+// module with its classification and the license the module is under when all of them are combined.
+type ModuleResult struct {
+	Module      string
+	Version     string
+	Files       []ModuleFile
+	Conclusion  string // example: "Apache 2.0 OR MIT License" for a module with LICENSE-APACHE and LICENSE-MIT.
+	Expression  string `json:",omitempty"`
+	Status      string
+	Category    string   `json:",omitempty"` // Most restrictive category of the licenses that apply.
+	Obligations []string `json:",omitempty"`
+	GitLink     string   `json:",omitempty"` // Link to the GitHub repo of the module.
+	GitLicense  string   `json:",omitempty"` // License GitHub declares for the repo, only set with -git-check.
+}
+
+// ModuleFile is a single license or NOTICE file of a module.
+type ModuleFile struct {
+	Filename   string
+	Filepath   string `json:",omitempty"` // Path of the copy in the LicFolder, empty if nothing was copied.
+	License    string
+	Copyrights []string `json:",omitempty"`
+	Classifier string   `json:",omitempty"` // Name of the Classifier that found the license.
+	Confidence float64  `json:",omitempty"`
+	SHA256     string   `json:",omitempty"` // SHA-256 of the text, shared by every file with the same text.
+}
+
+// isLicenseVariant is true if the name is a license file named after its license.
+func isLicenseVariant(name string) bool {
+	m := licenseVariant.FindStringSubmatch(name)
+	if m == nil {
+		return false
+	}
+	switch strings.ToLower(m[2]) {
+	case "txt", "md", "rst", "html", "markdown":
+		return false
+	}
+	return true
+}
+
+// alternatives gets the Filenames of the license files a user can choose between. These are the
+// license variants at the root of the module, if they hold at least two different licenses.
+func (m *scannedModule) alternatives() map[string]struct{} {
+	alts := make(map[string]struct{})
+	var lics []string
+	for _, l := range m.Licenses {
+		if l.License == unknownLicense || l.License == noLicense || l.License == noticeFile {
+			continue
+		}
+		dir, name := filepath.Split(l.Info.Filename)
+		if filepath.Clean(dir) != filepath.Clean(m.Name) || !isLicenseVariant(name) {
+			continue
+		}
+		alts[l.Info.Filename] = struct{}{}
+		lics = appendUnique(lics, l.License)
+	}
+	if len(lics) < 2 {
+		return map[string]struct{}{}
+	}
+	return alts
+}
+
+// splitLicenses splits the licenses of a module into the alternatives of a dual-licensed module and
+// the licenses that always apply. NOTICE files aren't licenses and are left out.
+func (m *scannedModule) splitLicenses() (alternatives []string, required []string) {
+	alts := m.alternatives()
+	for _, l := range m.Licenses {
+		if l.License == noticeFile {
+			continue
+		}
+		if _, ok := alts[l.Info.Filename]; ok {
+			alternatives = appendUnique(alternatives, l.License)
+			continue
+		}
+		required = appendUnique(required, l.License)
+	}
+	return alternatives, required
+}
+
+// conclusion combines the licenses of a module into a single license (example: "Apache 2.0 OR MIT License").
+func (m *scannedModule) conclusion() string {
+	alternatives, required := m.splitLicenses()
+	return combineLicenses(alternatives, required)
+}
+
+// withoutCovered removes the licenses that are also in the list with an exception, the exception
+// only adds permissions to the license.
+func withoutCovered(lics []string) []string {
+	var kept []string
+	for _, lic := range lics {
+		covered := false
+		for _, other := range lics {
+			if strings.HasPrefix(other, lic+withOperator) {
+				covered = true
+			}
+		}
+		if !covered {
+			kept = append(kept, lic)
+		}
+	}
+	return kept
+}
+
+// combineLicenses joins the alternatives with OR and everything else with AND. Terms that are
+// already compound are put in parentheses.
+func combineLicenses(alternatives, required []string) string {
+	sort.Strings(alternatives)
+	terms := withoutCovered(required)
+	if len(alternatives) > 0 {
+		terms = append(terms, strings.Join(alternatives, " OR "))
+	}
+	sort.Strings(terms)
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	for i, t := range terms {
+		if strings.Contains(t, " OR ") || strings.Contains(t, " AND ") || strings.Contains(t, " WITH ") {
+			terms[i] = "(" + t + ")"
+		}
+	}
+	return strings.Join(terms, " AND ")
+}
+
+// evaluateModule gets the policy status of a module. The best of the alternatives is used and every
+// other license has to be allowed as well.
+func (p policy) evaluateModule(alternatives, required []string) string {
+	lics := withoutCovered(required)
+	if len(alternatives) > 0 {
+		lics = append(lics, p.bestAlternative(alternatives))
+	}
+	return p.evaluateAll(lics)
+}
+
+// modulesInOrder gets the modules of the scan sorted by module and version.
+func (s *Scanner) modulesInOrder() []*scannedModule {
+	mods := make([]*scannedModule, 0, len(s.Modules))
+	for _, mod := range s.Modules {
+		mods = append(mods, mod)
+	}
+	sort.Slice(mods, func(i, j int) bool { return lessModuleName(mods[i].Name, mods[j].Name) })
+	return mods
+}
+
+// moduleName gets the module@version a LicenseInfo Filename belongs to, or the folder of the file if
+// it isn't in a module.
+func moduleName(filename string) string {
+	mod, ver := splitModuleVersion(filename)
+	if mod == "" {
+		return filepath.ToSlash(filepath.Dir(filename))
+	}
+	return mod + "@" + ver
+}
+
+// lessModuleName orders names of the form module@version/path by module path, then by version and
+// then by the whole name. Names outside of a module come first.
+func lessModuleName(a, b string) bool {
+	aMod, aVer := splitModuleVersion(a)
+	bMod, bVer := splitModuleVersion(b)
+	if aMod != bMod {
+		return aMod < bMod
+	}
+	if aVer != bVer {
+		return compareVersions(aVer, bVer) < 0
+	}
+	return a < b
+}
+
+// moduleResults gets the result of every module of the scan sorted by module and version.
+func (s *Scanner) moduleResults() []ModuleResult {
+	results := make([]ModuleResult, 0, len(s.Modules))
+	for _, mod := range s.modulesInOrder() {
+		results = append(results, s.moduleResult(mod))
+	}
+	return results
+}
+
+// moduleResult gets the ModuleResult of a scanned module.
+func (s *Scanner) moduleResult(mod *scannedModule) ModuleResult {
+	name, ver := splitModuleVersion(mod.Name)
+	if name == "" {
+		name = filepath.ToSlash(mod.Name)
+	}
+	alternatives, required := mod.splitLicenses()
+	result := ModuleResult{
+		Module:     name,
+		Version:    ver,
+		Conclusion: mod.Conclusion,
+		Expression: mod.Expression,
+		Status:     s.Policy.evaluateModule(alternatives, required),
+	}
+	result.Category, result.Obligations = s.moduleTerms(alternatives, required)
+	for _, l := range mod.Licenses {
+		file := ModuleFile{Filename: l.Info.Filename, License: l.License, Copyrights: l.Info.Copyrights,
+			Classifier: l.Info.Classifier, Confidence: l.Info.Confidence, SHA256: l.Info.SHA256}
+		if strings.HasPrefix(l.Info.Filepath, licensesFolder+"/") {
+			file.Filepath = l.Info.Filepath
+		}
+		result.Files = append(result.Files, file)
+		if result.GitLink == "" {
+			result.GitLink = l.Info.GitLink
+		}
+		if result.GitLicense == "" {
+			result.GitLicense = l.Info.GitLicense
+		}
+	}
+	sort.Slice(result.Files, func(i, j int) bool { return result.Files[i].Filename < result.Files[j].Filename })
+	return result
+}
+
+// createModulesFile writes the result of every module into the modulesFile and the summary of their
+// obligations into the obligationsFile.
+func createModulesFile(scanner Scanner) error {
+	results := scanner.moduleResults()
+	err := scanner.createReportFile(modulesFile, func(w io.Writer) error {
+		return writeJson(w, results, "modules")
+	})
+	if err != nil {
+		return err
+	}
+	return scanner.createReportFile(obligationsFile, func(w io.Writer) error {
+		return writeObligations(w, results)
+	})
+}
+
+// createHtmlModules creates the modulesHtml page, it lists the license files of every module
+// under the module's conclusion.
+func (l *Launch) createHtmlModules() error {
+	layout := `<!DOCTYPE html>
+		<html lang="en">
+		<head>
+			<meta charset="UTF-8">
+		  <title>Scan Results by Module</title>
+		</head>
+		<body>
+		  <p><a href="report.html">Report</a> | <a href="index.html">By license</a></p>
+		  {{range .}}
+		  <h2>{{.Module}} {{.Version}}</h2>
+		  <p><strong>{{.Conclusion}}</strong> ({{.Status}}{{if .Category}}, {{.Category}}{{end}})</p>
+		  {{if .Obligations}}<p>Obligations: {{join .Obligations ", "}}</p>{{end}}
+		  <ul>
+			  {{range $file := .Files}}
+			  <li>{{with relativeURL $file.Filepath}}<a href="{{.}}">{{$file.Filename}}</a>{{else}}{{$file.Filename}}{{end}}: {{$file.License}}</li>
+			  {{end}}
+		  </ul>
+		  {{end}}
+		</body>
+		</html>`
+	tmpl := template.Must(template.New("modules").Funcs(template.FuncMap{"join": strings.Join, "relativeURL": relativeURL}).Parse(layout))
+	return l.createReportFile(modulesHtml, func(w io.Writer) error {
+		err := tmpl.Execute(w, l.Scanner.moduleResults())
+		if err != nil {
+			return fmt.Errorf("error executing html: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,100 @@
+package lic
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestIsLicenseVariant(t *testing.T) {
+	for name, want := range map[string]bool{
+		"LICENSE-MIT":        true,
+		"LICENSE.APACHE":     true,
+		"LICENSE-APACHE.txt": true,
+		"COPYING-GPL":        true,
+		"LICENSE":            false,
+		"LICENSE.txt":        false,
+		"LICENSE.md":         false,
+		"COPYING":            false,
+	} {
+		if got := isLicenseVariant(name); got != want {
+			t.Errorf("isLicenseVariant(%s) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestModuleResults(t *testing.T) {
+	proxy := newTestProxy(t)
+	proxy.add("example.com/dual", "v1.0.0", map[string]string{
+		"LICENSE-MIT":    "Permission is hereby granted",
+		"LICENSE-APACHE": "Apache License Version 2.0",
+	})
+	proxy.add("example.com/both", "v1.0.0", map[string]string{
+		"LICENSE":        "Permission is hereby granted",
+		"COPYING":        "Apache License Version 2.0",
+		"vendor/LICENSE": "Permission is hereby granted",
+	})
+	dst := t.TempDir()
+	scan := newProxyScanner(t, proxy, dst, "modules")
+	scan.Licenses = licenses{
+		{Name: "MIT License", SPDX: "MIT", Lines: []string{DefinitionFormat("Permission is hereby granted")}},
+		{Name: "Apache 2.0", SPDX: "Apache-2.0", Lines: []string{DefinitionFormat("Apache License Version 2.0")}},
+	}
+	scan.Policy = policy{Allow: []string{"MIT License"}, Deny: []string{"Apache 2.0"}}
+	err := scan.ScanPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := os.ReadFile(filepath.Join(dst, scan.LicFolder, modulesFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []ModuleResult
+	err = json.Unmarshal(bs, &results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].Module != "example.com/both" || results[1].Module != "example.com/dual" {
+		t.Fatalf("Unexpected modules: %+v", results)
+	}
+	if r := results[0]; r.Conclusion != "Apache 2.0 AND MIT License" || r.Expression != "Apache-2.0 AND MIT" || r.Status != statusDenied || len(r.Files) != 3 {
+		t.Fatalf("Unexpected result for both: %+v", r)
+	}
+	if r := results[1]; r.Conclusion != "Apache 2.0 OR MIT License" || r.Expression != "Apache-2.0 OR MIT" || r.Status != statusAllowed || len(r.Files) != 2 {
+		t.Fatalf("Unexpected result for dual: %+v", r)
+	}
+}
+
+func TestSortedOutput(t *testing.T) {
+	lt := map[string][]LicenseInfo{"MIT License": {
+		{Filename: "example.com/b@v1.0.0/LICENSE"},
+		{Filename: "example.com/a@v1.10.0/LICENSE"},
+		{Filename: "example.com/a@v1.9.0/sub/LICENSE"},
+		{Filename: "example.com/a@v1.9.0/LICENSE"},
+	}}
+	sortLicenseTypes(lt)
+	var got []string
+	for _, info := range lt["MIT License"] {
+		got = append(got, info.Filename)
+	}
+	want := []string{"example.com/a@v1.9.0/LICENSE", "example.com/a@v1.9.0/sub/LICENSE", "example.com/a@v1.10.0/LICENSE", "example.com/b@v1.0.0/LICENSE"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected order: %v", got)
+	}
+
+	out := NewMemSink()
+	l := Launch{Repo: "example.com/repo", Output: out}
+	for _, stamp := range []bool{false, true} {
+		l.Timestamp = stamp
+		if err := l.createMetadataFile(); err != nil {
+			t.Fatal(err)
+		}
+		var meta scanMetadata
+		json.Unmarshal(out.Files()[metadataFile], &meta)
+		if (meta.Generated != "") != stamp {
+			t.Errorf("Generated should only be set with Timestamp: %+v", meta)
+		}
+	}
+}
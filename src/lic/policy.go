@@ -0,0 +1,143 @@
+package lic
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// policyJson is the json file that holds the license policy.
+const policyJson = "policy.json"
+
+// The policy statuses a license can have, ordered from best to worst.
+const (
+	statusAllowed = "allowed"
+	statusReview  = "review"
+	statusDenied  = "denied"
+)
+
+// overrideSuffix is added to the license name of overrided licenses.
+const overrideSuffix = " OVERRIDE"
+
+// policy is a struct that holds the licenses that are allowed or denied, by name or by category.
+// Licenses in neither list need a review. A license listed by name ignores the category lists.
+type policy struct {
+	Allow           []string
+	Deny            []string
+	AllowCategories []string
+	DenyCategories  []string
+	licenses        licenses // Used to get the category of a license.
+	exceptions      licenses
+}
+
+// initPolicy creates a policy using the data stored in PolicyJson. If there is no policy file
+// every license is allowed except the Unknown License and No License findings.
+func initPolicy(gopath string) (policy, error) {
+	pol, err := readPolicy(configFile(gopath, "DES_POLICY", policyJson)) // Use the DES_POLICY environment variable to change the path of the policy file.
+	if err != nil {
+		return policy{}, err
+	}
+	if pol.hasCategories() {
+		pol.licenses, err = InitLicense(gopath)
+		if err != nil {
+			return policy{}, err
+		}
+		pol.exceptions, err = initExceptions(gopath)
+		if err != nil {
+			return policy{}, err
+		}
+	}
+	return pol, nil
+}
+
+// readPolicy creates a policy using the data stored in policyFile, the licenses and exceptions used for
+// the category rules are left for the caller to set.
+func readPolicy(policyFile string) (policy, error) {
+	pol := policy{}
+	err := initJsonConfigs(policyFile, &pol)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Println("No policy config files leveraged")
+			return policy{}, nil
+		}
+		return policy{}, fmt.Errorf("error checking file: %w", err)
+	}
+	return pol, nil
+}
+
+// hasCategories is true if the policy has category rules, those need the licenses and exceptions.
+func (p policy) hasCategories() bool {
+	return len(p.AllowCategories)+len(p.DenyCategories) > 0
+}
+
+// evaluate gets the policy status of a license.
+func (p policy) evaluate(lic string) string {
+	lic = strings.TrimSuffix(lic, overrideSuffix)
+	for _, deny := range p.Deny {
+		if strings.EqualFold(deny, lic) {
+			return statusDenied
+		}
+	}
+	for _, allow := range p.Allow {
+		if strings.EqualFold(allow, lic) {
+			return statusAllowed
+		}
+	}
+	category, _ := licenseTerms(p.licenses, p.exceptions, lic)
+	if category != "" {
+		for _, deny := range p.DenyCategories {
+			if strings.EqualFold(deny, category) {
+				return statusDenied
+			}
+		}
+		for _, allow := range p.AllowCategories {
+			if strings.EqualFold(allow, category) {
+				return statusAllowed
+			}
+		}
+	}
+	if len(p.Allow)+len(p.AllowCategories) == 0 && lic != unknownLicense && lic != noLicense {
+		return statusAllowed
+	}
+	return statusReview
+}
+
+// bestAlternative gets the alternative of a dual-licensed module with the best policy status, ties
+// go to the first one by name.
+func (p policy) bestAlternative(alternatives []string) string {
+	best := ""
+	for _, lic := range alternatives {
+		if best == "" || statusRank(p.evaluate(lic)) < statusRank(p.evaluate(best)) ||
+			(p.evaluate(lic) == p.evaluate(best) && lic < best) {
+			best = lic
+		}
+	}
+	return best
+}
+
+// evaluateAll gets the worst policy status of a list of licenses.
+func (p policy) evaluateAll(lics []string) string {
+	status := statusAllowed
+	for _, lic := range lics {
+		s := p.evaluate(lic)
+		if statusRank(s) > statusRank(status) {
+			status = s
+		}
+	}
+	return status
+}
+
+// statusRank orders the policy statuses, a higher rank is worse.
+func statusRank(status string) int {
+	switch status {
+	case statusAllowed:
+		return 0
+	case statusReview:
+		return 1
+	case statusDenied:
+		return 2
+	}
+	return -1
+}
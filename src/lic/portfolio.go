@@ -0,0 +1,297 @@
+package lic
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// portfolioJson is the json file in the dst that holds the combined report of a multi repo scan.
+const portfolioJson = "portfolio.json"
+
+// portfolioHtml is the html version of the portfolioJson.
+const portfolioHtml = "portfolio.html"
+
+// Portfolio is a struct that holds all info needed to scan multiple repos in one run. Modules shared
+// between repos are only scanned, and checked with the githubapi, once.
+type Portfolio struct {
+	Launch  Launch // Settings used for every repo, Repo and Version are set from Repos.
+	Repos   []PortfolioRepo
+	Results map[string]map[string]*scannedModule
+}
+
+// PortfolioRepo is a single repo from a repos file.
+type PortfolioRepo struct {
+	Repo    string
+	Version string
+}
+
+// portfolioReport is the combined report written to the portfolioJson.
+type portfolioReport struct {
+	Repos   map[string]map[string][]string // repo -> license -> modules
+	Modules map[string]portfolioModule     // module -> licenses and repos using it
+}
+
+// portfolioModule is a single row of the cross-repo module to license matrix.
+type portfolioModule struct {
+	Licenses []string
+	Repos    []string
+}
+
+// ReadReposFile reads a repos file. Each line is a repo optionally followed by the version to scan,
+// empty lines and lines starting with # are ignored.
+func ReadReposFile(filename string) ([]PortfolioRepo, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file:  file: %s err: %w", filename, err)
+	}
+	defer file.Close()
+	var repos []PortfolioRepo
+	sc := bufio.NewScanner(file)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		r := PortfolioRepo{Repo: fields[0]}
+		if len(fields) > 1 {
+			r.Version = fields[1]
+		}
+		repos = append(repos, r)
+	}
+	if err = sc.Err(); err != nil {
+		return nil, fmt.Errorf("error reading file:  file: %s err: %w", filename, err)
+	}
+	return repos, nil
+}
+
+// name returns the key used for the repo in the portfolio report.
+func (r PortfolioRepo) name() string {
+	if r.Version == "" {
+		return r.Repo
+	}
+	return r.Repo + "@" + r.Version
+}
+
+// unsafeFolderChars are the characters of a repo name or version that aren't kept in a folder name.
+var unsafeFolderChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// licFolder gets the folder the report of the repo is written into (example: owner_repo_v1.0.0_Licenses).
+// It holds the owner, the repo and the version, so repos with the same name, or the same repo at two
+// versions, don't share a folder.
+func (r PortfolioRepo) licFolder() string {
+	l := Launch{Repo: r.Repo}
+	_, _, name := l.repoPath()
+	parts := strings.Split(name, "/")
+	if len(parts) > 2 {
+		parts = parts[len(parts)-2:]
+	}
+	if r.Version != "" {
+		parts = append(parts, r.Version)
+	}
+	return unsafeFolderChars.ReplaceAllString(strings.Join(parts, "_"), "-") + "_Licenses"
+}
+
+// checkRepos makes sure no two repos are written into the same folder, the later scan would overwrite,
+// and prune, the report of the earlier one.
+func (p *Portfolio) checkRepos() error {
+	folders := make(map[string]string)
+	for _, r := range p.Repos {
+		folder := r.licFolder()
+		if other, ok := folders[folder]; ok {
+			return fmt.Errorf("error in repos: %s and %s would both be written into %s", other, r.name(), folder)
+		}
+		folders[folder] = r.name()
+	}
+	return nil
+}
+
+// LaunchPortfolio scans every repo in Repos into its own owner_repo_version_Licenses folder and then
+// writes the combined report into the dst.
+func (p *Portfolio) LaunchPortfolio() error {
+	return p.LaunchPortfolioContext(context.Background())
+}
+
+// LaunchPortfolioContext is LaunchPortfolio with a context. If the scan of a repo is interrupted the
+// remaining repos are skipped and the combined report holds the repos scanned so far.
+func (p *Portfolio) LaunchPortfolioContext(ctx context.Context) error {
+	return withArchive(p.Launch.Archive, &p.Launch.Output, func() error {
+		return p.launchPortfolio(ctx)
+	})
+}
+
+// launchPortfolio scans every repo and writes the combined report into the Output of the Launch.
+func (p *Portfolio) launchPortfolio(ctx context.Context) error {
+	err := p.checkRepos()
+	if err != nil {
+		return err
+	}
+	if p.Launch.GitCheck && (p.Launch.GitUser == "" || p.Launch.GitToken == "") {
+		p.Launch.GitToken, p.Launch.GitUser, err = gitInfo()
+		if err != nil {
+			return err
+		}
+	}
+	cache := make(map[string]*scannedModule)
+	p.Results = make(map[string]map[string]*scannedModule)
+	var findingsErr error
+	for _, r := range p.Repos {
+		log.Println("Scanning repo: ", r.name())
+		l := p.Launch
+		l.Repo = r.Repo
+		l.Version = r.Version
+		l.Ref = r.Version
+		l.LicFolder = r.licFolder()
+		l.ModuleCache = cache
+		err = l.LaunchProgramContext(ctx)
+		if l.Partial {
+			p.Results[r.name()] = l.Scanner.Modules
+			reportErr := p.createPortfolioReport()
+			if reportErr != nil {
+				return reportErr
+			}
+			return fmt.Errorf("error scanning repo: repo: %s err: %w", r.name(), err)
+		}
+		if errors.Is(err, ErrNewFindings) {
+			// Keep scanning the other repos, the findings are reported once the portfolio is written.
+			findingsErr = fmt.Errorf("repo: %s err: %w", r.name(), err)
+		} else if err != nil {
+			return fmt.Errorf("error scanning repo: repo: %s err: %w", r.name(), err)
+		}
+		p.Results[r.name()] = l.Scanner.Modules
+	}
+	err = p.createPortfolioReport()
+	if err != nil {
+		return err
+	}
+	return findingsErr
+}
+
+// createPortfolioReport writes the portfolioJson and, if -tohtml is used, the portfolioHtml.
+func (p *Portfolio) createPortfolioReport() error {
+	report := portfolioReport{
+		Repos:   make(map[string]map[string][]string),
+		Modules: make(map[string]portfolioModule),
+	}
+	for repoName, modules := range p.Results {
+		byLicense := make(map[string][]string)
+		for mod, result := range modules {
+			pm := report.Modules[mod]
+			pm.Repos = appendUnique(pm.Repos, repoName)
+			for _, l := range result.Licenses {
+				if l.License == noticeFile {
+					continue
+				}
+				byLicense[l.License] = appendUnique(byLicense[l.License], mod)
+				pm.Licenses = appendUnique(pm.Licenses, l.License)
+			}
+			report.Modules[mod] = pm
+		}
+		for _, mods := range byLicense {
+			sort.Strings(mods)
+		}
+		report.Repos[repoName] = byLicense
+	}
+	for _, pm := range report.Modules {
+		sort.Strings(pm.Licenses)
+		sort.Strings(pm.Repos)
+	}
+	out := p.Launch.output()
+	err := writeReport(out, "", portfolioJson, func(w io.Writer) error {
+		return writeJson(w, report, "portfolio")
+	})
+	if err != nil {
+		return fmt.Errorf("error writing portfolio: %w", err)
+	}
+	if p.Launch.ToHTML {
+		return writeReport(out, "", portfolioHtml, report.writePortfolioHtml)
+	}
+	return nil
+}
+
+// writePortfolioHtml writes the portfolio report as a html page with a module to license matrix.
+func (r portfolioReport) writePortfolioHtml(w io.Writer) error {
+	repos := make([]string, 0, len(r.Repos))
+	for name := range r.Repos {
+		repos = append(repos, name)
+	}
+	sort.Strings(repos)
+	mods := make([]string, 0, len(r.Modules))
+	for name := range r.Modules {
+		mods = append(mods, name)
+	}
+	sort.Strings(mods)
+	type row struct {
+		Module   string
+		Licenses []string
+		Used     []bool
+	}
+	rows := make([]row, 0, len(mods))
+	for _, mod := range mods {
+		pm := r.Modules[mod]
+		used := make([]bool, len(repos))
+		for i, repoName := range repos {
+			for _, used2 := range pm.Repos {
+				if used2 == repoName {
+					used[i] = true
+				}
+			}
+		}
+		rows = append(rows, row{Module: mod, Licenses: pm.Licenses, Used: used})
+	}
+
+	layout := `<!DOCTYPE html>
+		<html lang="en">
+		<head>
+			<meta charset="UTF-8">
+		  <title>Portfolio Results</title>
+		</head>
+		<body>
+		  <h1>Licenses by repo</h1>
+		  {{range $repo, $lics := .Report.Repos}}
+		  <h2>{{$repo}}</h2>
+		  <ul>
+			  {{range $lic, $mods := $lics}}
+			  <li>{{$lic}} ({{len $mods}})</li>
+			  {{end}}
+		  </ul>
+		  {{end}}
+		  <h1>Module matrix</h1>
+		  <table border="1">
+			<tr><th>Module</th><th>Licenses</th>{{range .Repos}}<th>{{.}}</th>{{end}}</tr>
+			{{range .Rows}}
+			<tr><td>{{.Module}}</td><td>{{range $i, $l := .Licenses}}{{if $i}}, {{end}}{{$l}}{{end}}</td>{{range .Used}}<td>{{if .}}X{{end}}</td>{{end}}</tr>
+			{{end}}
+		  </table>
+		</body>
+		</html>`
+	tmpl := template.Must(template.New("portfolio").Parse(layout))
+	err := tmpl.Execute(w, struct {
+		Report portfolioReport
+		Repos  []string
+		Rows   []row
+	}{r, repos, rows})
+	if err != nil {
+		return fmt.Errorf("error executing html: %w", err)
+	}
+	return nil
+}
+
+// appendUnique appends s to list if it isn't already in it.
+func appendUnique(list []string, s string) []string {
+	for _, v := range list {
+		if v == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
@@ -0,0 +1,99 @@
+package lic
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPortfolioModuleCache(t *testing.T) {
+	proxy := newTestProxy(t)
+	proxy.add("example.com/shared", "v1.2.0", map[string]string{"LICENSE": "shared license"})
+	// The second repo must be served from the cache, a fetch from its empty proxy would fail.
+	empty := newTestProxy(t)
+	empty.Sum = proxy.Sum
+	dst := t.TempDir()
+	cache := make(map[string]*scannedModule)
+	portfolio := Portfolio{Launch: Launch{Dst: dst, ToHTML: true}, Results: make(map[string]map[string]*scannedModule)}
+	for i, repoProxy := range []*testProxy{proxy, empty} {
+		name := []string{"first", "second"}[i]
+		scan := newProxyScanner(t, repoProxy, dst, name)
+		scan.ModuleCache = cache
+		err := scan.ScanPath()
+		if err != nil {
+			t.Fatalf("FAILED SCAN %s: %v", name, err)
+		}
+		_, err = os.Stat(filepath.Join(dst, scan.LicFolder, "Licenses"))
+		if err != nil {
+			t.Fatalf("Expected license copies for %s: %v", name, err)
+		}
+		portfolio.Results[name] = scan.Modules
+	}
+	err := portfolio.createPortfolioReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := os.ReadFile(filepath.Join(dst, portfolioJson))
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := portfolioReport{}
+	json.Unmarshal(bs, &report)
+	mod := report.Modules["example.com/shared@v1.2.0"]
+	if len(mod.Repos) != 2 || len(mod.Licenses) != 1 || mod.Licenses[0] != unknownLicense {
+		t.Fatalf("Unexpected portfolio module: %+v", report)
+	}
+	_, err = os.Stat(filepath.Join(dst, portfolioHtml))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPortfolioVersions(t *testing.T) {
+	gopath := testGopath(t)
+	proxy := newTestProxy(t)
+	mit := proxy.add("example.com/mit", "v1.0.0", map[string]string{"LICENSE": testMIT})
+	zlib := proxy.add("example.com/zlib", "v1.0.0", map[string]string{"LICENSE": testZlib})
+
+	origin := filepath.Join(t.TempDir(), "owner", "repo")
+	err := os.MkdirAll(origin, os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, origin, "init", "-q")
+	for tag, sum := range map[string]string{"v1": "example.com/mit v1.0.0 " + mit + "\n", "v2": "example.com/zlib v1.0.0 " + zlib + "\n"} {
+		err = os.WriteFile(filepath.Join(origin, "go.sum"), []byte(sum), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		runGit(t, origin, "add", "-A")
+		runGit(t, origin, "commit", "-q", "-m", tag)
+		runGit(t, origin, "tag", tag)
+	}
+
+	dst := t.TempDir()
+	repo := "file://" + filepath.ToSlash(origin)
+	portfolio := Portfolio{Launch: Launch{Dst: dst, Gopath: gopath, GoProxy: proxy.URL()},
+		Repos: []PortfolioRepo{{Repo: repo, Version: "v1"}, {Repo: repo, Version: "v2"}}}
+	err = portfolio.LaunchPortfolio()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for folder, want := range map[string]string{"owner_repo_v1_Licenses": testMIT, "owner_repo_v2_Licenses": testZlib} {
+		bs, err := os.ReadFile(filepath.Join(dst, folder, licensesFolder, licenseHash([]byte(want))))
+		if err != nil || string(bs) != want {
+			t.Errorf("Expected the copy of each version in its own folder %s: %v", folder, err)
+		}
+		if entries, _ := os.ReadDir(filepath.Join(dst, folder, licensesFolder)); len(entries) != 1 {
+			t.Errorf("Expected one copy in %s: %v", folder, entries)
+		}
+	}
+
+	portfolio.Repos = append(portfolio.Repos, PortfolioRepo{Repo: repo, Version: "v1"})
+	err = portfolio.LaunchPortfolio()
+	if err == nil || !strings.Contains(err.Error(), "owner_repo_v1_Licenses") {
+		t.Fatalf("Expected repos written into the same folder to be rejected, got: %v", err)
+	}
+}
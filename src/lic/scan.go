@@ -1,15 +1,18 @@
 package lic
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"html/template"
 	"io/fs"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // unknownLicense is the key for any licenses that we cannot find in our DefinedJson.
@@ -38,66 +41,151 @@ type Scanner struct {
 	Inclusions        inclusions
 	Override          overrides
 	Licenses          licenses
-	LicenseType       map[string][]licenseInfo
+	Exceptions        licenses     // License exceptions (example: Classpath exception) that are detected alongside the licenses.
+	Classifiers       []Classifier // Asked before the built-in classifiers, initialized with the registered classifiers.
+	LicenseType       map[string][]LicenseInfo
+	Fetcher           *proxyFetcher             // If set modules are fetched from a GOPROXY instead of the ModPath.
+	Modules           map[string]*scannedModule // All modules found in this scan.
+	ModuleCache       map[string]*scannedModule // If set, modules shared with earlier scans are replayed from here.
+	CurrentModule     *scannedModule
+	Project           *scannedModule // Licenses of the scanned repo itself, the outbound license of the project.
+	CloneDir          string         // Path of the scanned clone if it isn't in GOPATH/src (a worktree or temporary clone).
+	CloneName         string         // Name of the scanned clone in the form host/owner/reponame.
+	Policy            policy
+	InMemory          bool          // Keep the license copies and reports in memory, nothing is written during the scan.
+	DownloadTimeout   time.Duration // Limit for each module fetched from a GOPROXY, 0 for no limit.
+	ModFS             fs.FS         // Module cache the dependencies are read from, the ModPath on disk if nil.
+	Output            Sink          // Where the license copies and reports are written, the DstPath on disk if nil.
 }
 
-// licenseInfo is a struct that holds License information for use in making the LicTypesFile and all html files.
-type licenseInfo struct {
+// scannedModule holds the results of scanning a single module so they can be reused by
+// other scans in the same run.
+type scannedModule struct {
+	Name        string // Path of the module relative to the ModPath (example: github.com/!j!c!price0024/lic-col@v1.0.0).
+	Licenses    []scannedLicense
+	Copies      []licenseCopy
+	SPDXHeaders []string // License expressions found in SPDX-License-Identifier headers of source files.
+	Expression  string   // Combined license expression of the module.
+	Conclusion  string   // Combined license of the module using the defined license names.
+}
+
+// scannedLicense is a LicenseInfo and the license it was classified as.
+type scannedLicense struct {
+	License string
+	Info    LicenseInfo
+}
+
+// licenseCopy is the data of a license file that was copied into the LicFolder.
+type licenseCopy struct {
+	Path string
+	Data []byte
+}
+
+// LicenseInfo is a struct that holds License information for use in making the LicTypesFile and all html files.
+type LicenseInfo struct {
 	Filepath   string
 	Filename   string
 	GitLink    string
 	GitLicense string
+	Copyrights []string `json:",omitempty"`
+	Classifier string   `json:",omitempty"` // Name of the Classifier that found the license.
+	Confidence float64  `json:",omitempty"` // Confidence of the Classifier, from 0 to 1.
+	SHA256     string   `json:",omitempty"` // SHA-256 of the text, the copy in the LicFolder is named after it.
+}
+
+// configPaths maps the name of each config file to the path it is read from.
+type configPaths map[string]string
+
+// defaultConfigPaths gets the paths of the config files in GOPATH, the DES_* environment variables
+// override them.
+func defaultConfigPaths(gopath string) configPaths {
+	return configPaths{
+		exclusionsJson:    configFile(gopath, "DES_EXCL", exclusionsJson),
+		excludedEXTJson:   configFile(gopath, "DES_EXT", excludedEXTJson),
+		inclusionsJson:    configFile(gopath, "DES_INCL", inclusionsJson),
+		definedJson:       configFile(gopath, "DES_LIC", definedJson),
+		exceptionsJson:    configFile(gopath, "DES_EXCEPT", exceptionsJson),
+		overrideJson:      configFile(gopath, "DES_OVER", overrideJson),
+		policyJson:        configFile(gopath, "DES_POLICY", policyJson),
+		compatibilityJson: configFile(gopath, "DES_COMPAT", compatibilityJson),
+	}
 }
 
 // initScanner creates a scanner object for scan path.
 func initScanner(gopath, modpath, dstpath, gitUser, gitToken string, tohtml bool) (*Scanner, error) {
+	scan, err := newScanner(defaultConfigPaths(gopath))
+	if err != nil {
+		return nil, err
+	}
+	api, err := createCache(gopath)
+	if err != nil {
+		return nil, err
+	}
+	scan.Gopath = gopath
+	scan.ModPath = modpath
+	scan.DstPath = dstpath
+	scan.GitUser = gitUser
+	scan.GitToken = gitToken
+	scan.ToHTML = tohtml
+	scan.CompletedApiCheck = api
+	return scan, nil
+}
 
-	excls, err := initExclusions(gopath)
+// newScanner creates a scanner using the config files at paths.
+func newScanner(paths configPaths) (*Scanner, error) {
+
+	excls, err := readExclusions(paths[exclusionsJson])
 	if err != nil {
 		return nil, err
 	}
 
-	exc, err := initExcludedEXT(gopath)
+	exc, err := readExcludedEXT(paths[excludedEXTJson])
 	if err != nil {
 		return nil, err
 	}
 
-	inc, err := initInclusions(gopath)
+	inc, err := readInclusions(paths[inclusionsJson])
 	if err != nil {
 		return nil, err
 	}
 
-	licenses, err := InitLicense(gopath)
+	licenses, err := readLicenses(paths[definedJson])
 	if err != nil {
 		return nil, err
 	}
 
-	ovr, err := initOverrides(gopath)
+	excs, err := readExceptions(paths[exceptionsJson])
 	if err != nil {
 		return nil, err
 	}
-	api, err := createCache(gopath)
+
+	ovr, err := readOverrides(paths[overrideJson])
 	if err != nil {
 		return nil, err
 	}
+	pol, err := readPolicy(paths[policyJson])
+	if err != nil {
+		return nil, err
+	}
+	if pol.hasCategories() {
+		pol.licenses, pol.exceptions = licenses, excs
+	}
 	tmpl := initLicTemplate()
 
 	return &Scanner{
-		Gopath:            gopath,
-		ModPath:           modpath,
-		DstPath:           dstpath,
-		GitUser:           gitUser,
-		GitToken:          gitToken,
-		ToHTML:            tohtml,
 		Licensecanned:     false,
-		CompletedApiCheck: api,
+		CompletedApiCheck: make(completedApiCheck),
 		Template:          tmpl,
 		Licenses:          licenses,
+		Exceptions:        excs,
+		Classifiers:       registeredClassifiers(),
 		ExcludedEXT:       exc,
 		Exclusions:        excls,
 		Inclusions:        inc,
 		Override:          ovr,
-		LicenseType:       make(map[string][]licenseInfo)}, nil
+		Policy:            pol,
+		Modules:           make(map[string]*scannedModule),
+		LicenseType:       make(map[string][]LicenseInfo)}, nil
 }
 
 // dependencyCheck first conforms the dependency string provided by ScanPath into the correct format for
@@ -109,13 +197,10 @@ func (s *Scanner) dependencyCheck(d string) string {
 		return ""
 	}
 	ver := regexp.MustCompile(`\s`)
-	caps := regexp.MustCompile(`[A-Z]`)
 	dep := ver.ReplaceAllString(parts[0], "@")
-	dep = caps.ReplaceAllStringFunc(dep, func(s string) string { return "!" + strings.ToLower(s) })
-	parts = strings.Split(dep, "/")
-	path := filepath.Join(parts...)
-	path = filepath.Join(s.ModPath, path)
-	fstat, err := os.Stat(path)
+	dep = escapeModulePath(dep)
+	path := filepath.Join(s.ModPath, filepath.FromSlash(dep))
+	fstat, err := fs.Stat(s.modFS(), dep)
 
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -131,6 +216,14 @@ func (s *Scanner) dependencyCheck(d string) string {
 	return path
 }
 
+// modFS gets the file system the dependencies are read from.
+func (s *Scanner) modFS() fs.FS {
+	if s.ModFS != nil {
+		return s.ModFS
+	}
+	return os.DirFS(s.ModPath)
+}
+
 // getGitParts takes in a path that has github.com in it. It then splits the
 // path into the parts used by github. (example output: [github.com owner reponame]).
 func getGitParts(path string) []string {
@@ -143,8 +236,13 @@ func getGitParts(path string) []string {
 	} else {
 		basepath = filepath.Join(os.Getenv("GOPATH"), "pkg", "mod")
 	}
+	isDir := true
 	fi, err := os.Stat(path)
-	if err != nil {
+	if err == nil {
+		isDir = fi.IsDir()
+	} else if !strings.Contains(path, "@v") {
+		// Modules fetched from a GOPROXY only exist in memory, the version cleanup below
+		// handles them without needing to stat the path.
 		log.Println(err)
 		return []string{}
 	}
@@ -157,7 +255,7 @@ func getGitParts(path string) []string {
 		})
 	}
 	p := path
-	if !fi.IsDir() {
+	if !isDir {
 		p = filepath.Dir(path)
 	}
 	p = strings.ReplaceAll(p, basepath+string(filepath.Separator), "")
@@ -166,27 +264,57 @@ func getGitParts(path string) []string {
 	return strings.Split(p, string(filepath.Separator))
 }
 
-// getLink gets a link from the github repo if available.
-func getLink(path string) string {
-	parts := getGitParts(path)
+// clonePath maps a path inside the CloneDir to the path the clone would have in GOPATH/src. This keeps
+// the names of files found in a worktree or temporary clone the same as for a clone in GOPATH/src.
+func (s *Scanner) clonePath(path string) string {
+	if s.CloneDir == "" || s.CloneName == "" {
+		return path
+	}
+	if path != s.CloneDir && !strings.HasPrefix(path, s.CloneDir+string(filepath.Separator)) {
+		return path
+	}
+	return filepath.Join(os.Getenv("GOPATH"), "src", filepath.FromSlash(s.CloneName), strings.TrimPrefix(path, s.CloneDir))
+}
+
+// cleanPath is licPathCleanup for paths that may be inside the CloneDir.
+func (s *Scanner) cleanPath(path string, noSlashes bool) string {
+	return licPathCleanup(s.clonePath(path), noSlashes)
+}
+
+// gitParts is getGitParts for paths that may be inside the CloneDir.
+func (s *Scanner) gitParts(path string) []string {
+	if s.clonePath(path) == path {
+		return getGitParts(path)
+	}
+	if !strings.Contains(s.CloneName, "github.com") {
+		return []string{}
+	}
+	return strings.Split(s.CloneName, "/")
+}
+
+// link gets a link from the github repo if available.
+func (s *Scanner) link(path string) string {
+	parts := s.gitParts(path)
 	if len(parts) < 3 {
 		return ""
 	}
-	link := fmt.Sprintf("https://%s/%s/%s", parts[0], parts[1], parts[2])
-	return link
+	return fmt.Sprintf("https://%s/%s/%s", parts[0], parts[1], parts[2])
 }
 
 // getGitLicense get's the git license from the githubapi.
-func (s *Scanner) getGitLicense(path string) error {
+func (s *Scanner) getGitLicense(ctx context.Context, path string) error {
 	var err error
-	parts := getGitParts(path)
+	parts := s.gitParts(path)
 	gitLic := repo{}
 	var apiErr error
 	if len(parts) >= 3 && s.GitUser != "" && s.GitToken != "" {
 		lic, ok := s.CompletedApiCheck[fmt.Sprintf("%s/%s", parts[1], parts[2])]
 		if !ok {
-			gitLic, apiErr = getRepoInfo(parts[1], parts[2], s.GitUser, s.GitToken)
-			reachLimit := gitLic.calcGitApiSleep()
+			gitLic, apiErr = getRepoInfo(ctx, parts[1], parts[2], s.GitUser, s.GitToken)
+			reachLimit, err := gitLic.calcGitApiSleep(ctx)
+			if err != nil {
+				return err
+			}
 			if reachLimit {
 				s.GitUser = ""
 				s.GitToken = ""
@@ -201,7 +329,7 @@ func (s *Scanner) getGitLicense(path string) error {
 		log.Printf("Problem getting license info: %s/%s %v", parts[1], parts[2], apiErr)
 		log.Printf("If this is a private repo enter a username and personal acess token as Command Line Args")
 	}
-	if len(s.CompletedApiCheck)%10 == 0 {
+	if len(s.CompletedApiCheck)%10 == 0 && !s.InMemory {
 		err = createCacheFile(s.Gopath, s.CompletedApiCheck)
 		if err != nil {
 			return err
@@ -215,39 +343,75 @@ func (s *Scanner) getGitLicense(path string) error {
 // a -git-token and -git-user is provided the program will check the api and provide the current github license.
 // This will make the program wait a second everytime it is called.
 func (s *Scanner) ScanPath() error {
+	return s.ScanPathContext(context.Background())
+}
+
+// ScanPathContext is ScanPath with a context. Once the context is done the modules scanned so far are
+// kept, the module being scanned is dropped, and the error of the context is returned without writing
+// the scan files.
+func (s *Scanner) ScanPathContext(ctx context.Context) error {
 	var err error
+	if s.Fetcher != nil {
+		err = s.scanProxyPath(ctx)
+		if err != nil {
+			return err
+		}
+		return s.createScanFiles()
+	}
 	dependencies := strings.SplitAfterN(s.ProjectSum, "\n", -1)
 	toScan := ""
 	for _, d := range dependencies {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		d = strings.TrimSpace(d)
 		toScan = s.dependencyCheck(d)
 		if toScan == "" {
 			continue
 		}
-		err = s.getGitLicense(toScan)
+		newModule, err := s.startModule(s.cleanPath(toScan, false))
 		if err != nil {
 			return err
 		}
-		err := filepath.Walk(toScan, s.fileWalk)
+		if !newModule {
+			continue
+		}
+		modFS, err := fs.Sub(s.modFS(), filepath.ToSlash(strings.TrimPrefix(toScan, s.ModPath+string(filepath.Separator))))
 		if err != nil {
-			return err
+			s.dropModule()
+			return fmt.Errorf("error reading module: %w", err)
 		}
-		if !s.Licensecanned {
-			_, ok := s.LicenseType[noLicense]
-			licInfo := licenseInfo{Filename: licPathCleanup(toScan, false),
-				Filepath:   filepath.Dir(toScan),
-				GitLink:    getLink(toScan),
-				GitLicense: s.GitLicense}
-			if !ok {
-				s.LicenseType[noLicense] = []licenseInfo{licInfo}
-			} else {
-				s.LicenseType[noLicense] = append(s.LicenseType[noLicense], licInfo)
-			}
+		err = s.scanModule(ctx, modFS, toScan)
+		if err != nil {
+			return err
 		}
-		s.Licensecanned = false
-		s.GitLicense = ""
 	}
-	err = createLicTypesFile(*s)
+	return s.createScanFiles()
+}
+
+// createScanFiles writes the LicTypesFile and the other reports of the scan once it is completed.
+func (s *Scanner) createScanFiles() error {
+	sortLicenseTypes(s.LicenseType)
+	if s.InMemory {
+		return nil
+	}
+	err := createLicTypesFile(*s)
+	if err != nil {
+		return err
+	}
+	err = createLicenseTextsFile(*s)
+	if err != nil {
+		return err
+	}
+	err = createExpressionsFile(*s)
+	if err != nil {
+		return err
+	}
+	err = createAttributionFile(*s)
+	if err != nil {
+		return err
+	}
+	err = createModulesFile(*s)
 	if err != nil {
 		return err
 	}
@@ -255,51 +419,253 @@ func (s *Scanner) ScanPath() error {
 	return nil
 }
 
-// fileWalk is a Walkfn for filepath.Walk in ScanPath. It walks through all folders and files in a repo and looks for anything
-// relating to a license.
-func (s *Scanner) fileWalk(path string, info fs.FileInfo, err error) error {
+// finishModule adds the module to the noLicense list if no license was found in it and resets
+// the per module state of the scanner. Modules without a license file that declare their license
+// in SPDX headers are listed under those licenses instead.
+func (s *Scanner) finishModule(modDir string) {
+	if !s.Licensecanned {
+		licInfo := LicenseInfo{Filename: s.cleanPath(modDir, false),
+			Filepath:   filepath.Dir(modDir),
+			GitLink:    s.link(modDir),
+			GitLicense: s.GitLicense}
+		if s.CurrentModule != nil && len(s.CurrentModule.SPDXHeaders) > 0 {
+			for _, id := range s.CurrentModule.SPDXHeaders {
+				s.addLicense(id, licInfo)
+			}
+		} else {
+			s.addLicense(noLicense, licInfo)
+		}
+	}
+	if s.CurrentModule != nil {
+		s.attachExceptions(s.CurrentModule)
+		s.CurrentModule.Expression = s.moduleExpression(s.CurrentModule)
+		s.CurrentModule.Conclusion = s.CurrentModule.conclusion()
+	}
+	s.Licensecanned = false
+	s.GitLicense = ""
+	s.CurrentModule = nil
+}
+
+// startModule checks if the module was already scanned. If it was scanned during this scan it is
+// skipped, if it was scanned by an earlier scan sharing the ModuleCache its results are replayed
+// into this scan. It returns true if the module still needs to be scanned.
+func (s *Scanner) startModule(key string) (bool, error) {
+	_, ok := s.Modules[key]
+	if ok {
+		return false, nil
+	}
+	cached, ok := s.ModuleCache[key]
+	if ok {
+		s.Modules[key] = cached
+		for _, l := range cached.Licenses {
+			s.LicenseType[l.License] = append(s.LicenseType[l.License], l.Info)
+		}
+		for _, c := range cached.Copies {
+			err := s.copyLicense(c.Path, c.Data)
+			if err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	}
+	s.CurrentModule = &scannedModule{Name: key}
+	s.Modules[key] = s.CurrentModule
+	if s.ModuleCache != nil {
+		s.ModuleCache[key] = s.CurrentModule
+	}
+	return true, nil
+}
+
+// dropModule forgets the module currently being scanned and the licenses found in it so far, it is
+// used if the module couldn't be fetched or its scan was interrupted so the results only hold complete
+// modules.
+func (s *Scanner) dropModule() {
+	if s.CurrentModule == nil {
+		return
+	}
+	for _, l := range s.CurrentModule.Licenses {
+		infos := s.LicenseType[l.License]
+		for i := len(infos) - 1; i >= 0; i-- {
+			if infos[i].Filename == l.Info.Filename && infos[i].Filepath == l.Info.Filepath {
+				infos = append(infos[:i], infos[i+1:]...)
+				break
+			}
+		}
+		if len(infos) == 0 {
+			delete(s.LicenseType, l.License)
+		} else {
+			s.LicenseType[l.License] = infos
+		}
+	}
+	delete(s.Modules, s.CurrentModule.Name)
+	if s.ModuleCache[s.CurrentModule.Name] == s.CurrentModule {
+		delete(s.ModuleCache, s.CurrentModule.Name)
+	}
+	s.Licensecanned = false
+	s.GitLicense = ""
+	s.CurrentModule = nil
+}
+
+// scanModule walks fsys, the files of the module currently being scanned, and finishes the module.
+// If the walk fails or is interrupted the module is dropped.
+func (s *Scanner) scanModule(ctx context.Context, fsys fs.FS, modDir string) error {
+	err := s.getGitLicense(ctx, modDir)
+	if err == nil {
+		err = s.walkFS(ctx, fsys, modDir)
+	}
 	if err != nil {
-		return fmt.Errorf("error with file walk: %w", err)
+		s.dropModule()
+		return err
+	}
+	s.finishModule(modDir)
+	return nil
+}
+
+// addLicense adds the LicenseInfo to the LicenseType under the given license and records it on the
+// module currently being scanned.
+func (s *Scanner) addLicense(lic string, licInfo LicenseInfo) {
+	s.LicenseType[lic] = append(s.LicenseType[lic], licInfo)
+	if s.CurrentModule != nil {
+		s.CurrentModule.Licenses = append(s.CurrentModule.Licenses, scannedLicense{License: lic, Info: licInfo})
+	}
+}
+
+// copyLicense copies the license data into the LicFolder, as html if -tohtml is used, and records the
+// copy on the module currently being scanned.
+func (s *Scanner) copyLicense(path string, bs []byte) error {
+	if s.CurrentModule != nil {
+		s.CurrentModule.Copies = append(s.CurrentModule.Copies, licenseCopy{Path: path, Data: bs})
+	}
+	if s.InMemory {
+		return nil
+	}
+	if s.ToHTML {
+		return s.createHTMLLicense(bs)
+	}
+	return s.createLicFolder(bs)
+}
+
+// scanProxyPath is the ScanPath used when a GOPROXY fetcher is set. Every module with a zip hash
+// in ProjectSum is downloaded, verified and its license files are read straight out of the zip.
+// The paths used are the paths the module would have in the ModPath so the output is the same
+// as a scan of a go mod download.
+func (s *Scanner) scanProxyPath(ctx context.Context) error {
+	for _, e := range parseGoSum(s.ProjectSum) {
+		if e.ZipHash == "" {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		modDir := escapeModulePath(e.Module + "@" + e.Version)
+		modDir = filepath.Join(s.ModPath, filepath.FromSlash(modDir))
+		newModule, err := s.startModule(s.cleanPath(modDir, false))
+		if err != nil {
+			return err
+		}
+		if !newModule {
+			continue
+		}
+		log.Println("Fetching module: ", e.Module, e.Version)
+		fetchCtx, cancel := phaseContext(ctx, s.DownloadTimeout)
+		zr, err := s.Fetcher.fetchModule(fetchCtx, e)
+		cancel()
+		if err != nil {
+			s.dropModule()
+			return err
+		}
+		modFS, err := fs.Sub(zr, e.Module+"@"+e.Version)
+		if err != nil {
+			s.dropModule()
+			return fmt.Errorf("error reading module zip: %w", err)
+		}
+		err = s.scanModule(ctx, modFS, modDir)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkFS walks through all folders and files of fsys and looks for anything relating to a license. dir is
+// the path the root of fsys has on disk (or would have in the ModPath for a module zip), the files
+// found are named after it.
+func (s *Scanner) walkFS(ctx context.Context, fsys fs.FS, dir string) error {
+	return fs.WalkDir(fsys, ".", func(rel string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("error with file walk: %w", err)
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return s.scanEntry(fsys, rel, d, filepath.Join(dir, filepath.FromSlash(rel)))
+	})
+}
+
+// scanEntry checks a single file or folder found by walkFS, rel is its path in fsys and filePath its path on disk.
+func (s *Scanner) scanEntry(fsys fs.FS, rel string, d fs.DirEntry, filePath string) error {
+	if !d.IsDir() && isNoticeFile(d.Name()) {
+		if s.checkExcluded(filePath, d.Name()) {
+			return nil
+		}
+		bs, err := fs.ReadFile(fsys, rel)
+		if err != nil {
+			return fmt.Errorf("unable to read file: %w", err)
+		}
+		return s.scanNotice(filePath, bs)
 	}
-	if !isLicenseFile(path) {
-		ovrPath := strings.Split(path, s.ModPath+string(filepath.Separator))
+	if !isLicenseFile(filePath) {
+		ovrPath := strings.Split(filePath, s.ModPath+string(filepath.Separator))
 		if len(ovrPath) == 2 {
-			_, ok := s.Override[ovrPath[1]]
+			ovr, ok := s.Override[ovrPath[1]]
 			if ok {
 				s.Licensecanned = true
-				return s.scanOverride(path, ovrPath[1])
+				bs, err := fs.ReadFile(fsys, path.Join(rel, filepath.ToSlash(ovr.Filename)))
+				if err != nil {
+					return fmt.Errorf("unable to read file: %w", err)
+				}
+				return s.scanOverrideData(filePath, ovrPath[1], bs)
 			}
 		}
-		_, ok := s.Inclusions[info.Name()]
+		_, ok := s.Inclusions[d.Name()]
 		if !ok {
-			return nil
+			return s.scanSourceFile(fsys, rel, d)
 		}
 	}
 	s.Licensecanned = true
 
-	if s.checkExcluded(path, info.Name()) {
-		return nil
+	if s.checkExcluded(filePath, d.Name()) {
+		return s.scanSourceFile(fsys, rel, d)
 	}
 
-	if info.IsDir() {
+	if d.IsDir() {
 		return nil
 	}
-	s.Exclusions[path] = struct{}{}
-	bs, err := os.ReadFile(filepath.Join(path))
+	s.Exclusions[filePath] = struct{}{}
+	bs, err := fs.ReadFile(fsys, rel)
 	if err != nil {
 		return fmt.Errorf("unable to read file: %w", err)
 	}
-	s.checkLicenses(bs, path)
-	if s.ToHTML {
-		err = s.createHTMLLicense(path, bs)
-	} else {
-		err = s.createLicFolder(path, bs)
+	return s.scanLicenseData(filePath, bs)
+}
+
+// scanSourceFile scans a file that isn't copied as a license for SPDX headers if it is a source file.
+func (s *Scanner) scanSourceFile(fsys fs.FS, rel string, d fs.DirEntry) error {
+	if d.IsDir() || !isSourceFile(d.Name()) {
+		return nil
 	}
+	file, err := fsys.Open(rel)
 	if err != nil {
-		return err
+		return fmt.Errorf("unable to read file: %w", err)
 	}
+	defer file.Close()
+	return s.readSPDXHeader(file)
+}
 
-	return nil
+// scanLicenseData classifies the license file data found at path and copies it into the LicFolder.
+func (s *Scanner) scanLicenseData(path string, bs []byte) error {
+	s.checkLicenses(bs, path)
+	return s.copyLicense(path, bs)
 }
 
 // checkExcluded checks the given path/filename to see if they need to be excluded.
@@ -320,77 +686,59 @@ func (s *Scanner) checkExcluded(path, filename string) bool {
 func (s *Scanner) checkLicenses(bs []byte, path string) {
 	licDef := DefinitionFormat(string(bs))
 	classified := false
-	licensePath := filepath.Base(path) + licPathCleanup(filepath.Dir(path), true)
-	if s.ToHTML {
-		licensePath += ".html"
-	}
-	licInfo := licenseInfo{Filename: licPathCleanup(path, false),
-		Filepath:   fmt.Sprintf("Licenses/%s", licensePath),
-		GitLink:    getLink(path),
-		GitLicense: s.GitLicense}
-	for _, def := range s.Licenses {
-		matchesAll := TestLicense(licDef, def, false)
-		if matchesAll {
-			_, ok := s.LicenseType[def.Name]
-			if !ok {
-				s.LicenseType[def.Name] = []licenseInfo{licInfo}
-			} else {
-				s.LicenseType[def.Name] = append(s.LicenseType[def.Name], licInfo)
-			}
-			classified = true
-			break
+	licInfo := LicenseInfo{Filename: s.cleanPath(path, false),
+		Filepath:   s.copyName(bs),
+		SHA256:     licenseHash(bs),
+		GitLink:    s.link(path),
+		GitLicense: s.GitLicense,
+		Copyrights: extractCopyrights(bs)}
+	exc := s.checkException(licDef)
+	cand, classifier, ok := s.classify(bs, path)
+	if ok {
+		lic := cand.License
+		if exc != "" {
+			lic += withOperator + exc
 		}
+		licInfo.Classifier = classifier
+		licInfo.Confidence = cand.Confidence
+		s.addLicense(lic, licInfo)
+		classified = true
+	}
+	if !classified && exc != "" {
+		s.addLicense(exc, licInfo)
+		classified = true
 	}
 	if !classified {
-		_, ok := s.LicenseType[unknownLicense]
-		if !ok {
-			s.LicenseType[unknownLicense] = []licenseInfo{licInfo}
-		} else {
-			s.LicenseType[unknownLicense] = append(s.LicenseType[unknownLicense], licInfo)
-		}
+		s.addLicense(unknownLicense, licInfo)
 	}
 }
 
-// scanOverride scans all overrided files and creates the copies for them. It will be a .html file if -tohtml is used
-// in the Command Line Args.
-func (s *Scanner) scanOverride(path, ovrPath string) error {
-	licOvr := s.Override[ovrPath].License + " " + "OVERRIDE"
+// scanOverrideData records the override and copies the data of the overrided file.
+func (s *Scanner) scanOverrideData(path, ovrPath string, bs []byte) error {
+	licOvr := s.Override[ovrPath].License + overrideSuffix
 	ovrFile := filepath.Join(path, s.Override[ovrPath].Filename)
-	ovrFileName := fmt.Sprintf("Licenses/%s", filepath.Base(s.Override[ovrPath].Filename)+licPathCleanup(filepath.Dir(ovrFile), true))
-	if s.ToHTML {
-		ovrFileName += ".html"
-	}
-	licInfo := licenseInfo{
-		Filename:   licPathCleanup(ovrFile, false),
-		Filepath:   ovrFileName,
-		GitLink:    getLink(path),
+	licInfo := LicenseInfo{
+		Filename:   s.cleanPath(ovrFile, false),
+		Filepath:   s.copyName(bs),
+		SHA256:     licenseHash(bs),
+		GitLink:    s.link(path),
 		GitLicense: s.GitLicense,
+		Copyrights: extractCopyrights(bs),
 	}
 
-	_, ok := s.LicenseType[licOvr]
-	if !ok {
-		s.LicenseType[licOvr] = []licenseInfo{licInfo}
-	} else {
-		s.LicenseType[licOvr] = append(s.LicenseType[licOvr], licInfo)
-	}
-	bs, err := os.ReadFile(ovrFile)
-	if err != nil {
-		return fmt.Errorf("unable to read file: %w", err)
-	}
-	if s.ToHTML {
-		err = s.createHTMLLicense(ovrFile, bs)
-	} else {
-		err = s.createLicFolder(ovrFile, bs)
-	}
-	if err != nil {
-		return err
-	}
-	return nil
+	s.addLicense(licOvr, licInfo)
+	return s.copyLicense(ovrFile, bs)
 }
 
 // TestLicense is a function to help track down the differences between a license file and one of the defined
 // licenses.
 func TestLicense(licDef string, def definedLicense, debug bool) (matchesAll bool) {
+	if def.template != nil {
+		if debug {
+			log.Printf("Inside test license %v is matched by its SPDX template, not by lines", def.Name)
+		}
+		return false
+	}
 	matchesAll = true
 	for _, line := range def.Lines {
 		if !strings.Contains(licDef, line) {
@@ -0,0 +1,378 @@
+package lic
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Sink is where the output of a scan is written. Names are slash separated paths relative to the
+// root of the sink (example: repo_Licenses/licensetypes.json).
+type Sink interface {
+	WriteFile(name string, data []byte) error
+}
+
+// DirSink writes the output into a directory on disk, folders are made as needed.
+type DirSink string
+
+// WriteFile writes data into the file name of the directory, replacing it if it exists. The data is
+// written into a temporary file that is renamed once complete, so the file never holds a partial or
+// mixed copy.
+func (d DirSink) WriteFile(name string, data []byte) error {
+	if d == "" {
+		return fmt.Errorf("error writing output: no directory for %s", name)
+	}
+	if !fs.ValidPath(name) {
+		return fmt.Errorf("error writing output: invalid name %q", name)
+	}
+	dst := filepath.Join(string(d), filepath.FromSlash(name))
+	err := os.MkdirAll(filepath.Dir(dst), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("error making output directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(dst), "."+filepath.Base(dst)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("error writing output: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(data)
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), dst)
+	}
+	if err != nil {
+		return fmt.Errorf("error writing output: %w", err)
+	}
+	return nil
+}
+
+// dirOutput is a DirSink that remembers what it wrote, so the files left by earlier runs can be pruned.
+type dirOutput struct {
+	DirSink
+	mu      sync.Mutex
+	written map[string]struct{}
+}
+
+// newDirOutput creates a dirOutput writing into dir.
+func newDirOutput(dir string) *dirOutput {
+	return &dirOutput{DirSink: DirSink(dir), written: make(map[string]struct{})}
+}
+
+// WriteFile writes the file and records its name.
+func (d *dirOutput) WriteFile(name string, data []byte) error {
+	err := d.DirSink.WriteFile(name, data)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.written[name] = struct{}{}
+	return nil
+}
+
+// prune removes every file in folder that wasn't written, and the folders left empty, so the folder
+// only holds the output of this run. The folder has to be a folder inside the dir that this run wrote
+// into, the dir itself or any other folder is never pruned.
+func (d *dirOutput) prune(folder string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !fs.ValidPath(folder) || folder == "." {
+		return fmt.Errorf("error pruning output: %q isn't a folder inside %s", folder, string(d.DirSink))
+	}
+	if !d.wroteInto(folder) {
+		return fmt.Errorf("error pruning output: nothing was written into %s", folder)
+	}
+	root := filepath.Join(string(d.DirSink), filepath.FromSlash(folder))
+	var dirs []string
+	err := filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			dirs = append(dirs, p)
+			return nil
+		}
+		rel, err := filepath.Rel(string(d.DirSink), p)
+		if err != nil {
+			return err
+		}
+		if _, ok := d.written[filepath.ToSlash(rel)]; ok {
+			return nil
+		}
+		log.Println("Removing file of an earlier scan: ", p)
+		return os.Remove(p)
+	})
+	if err != nil {
+		return fmt.Errorf("error pruning output: %w", err)
+	}
+	// The deepest folders come last in walk order, removing them first lets their parents empty out.
+	for i := len(dirs) - 1; i > 0; i-- {
+		entries, err := os.ReadDir(dirs[i])
+		if err == nil && len(entries) == 0 {
+			os.Remove(dirs[i])
+		}
+	}
+	return nil
+}
+
+// wroteInto is true if a file was written into folder or one of its subfolders.
+func (d *dirOutput) wroteInto(folder string) bool {
+	for name := range d.written {
+		if strings.HasPrefix(name, folder+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// MemSink keeps the output in memory, it is safe for concurrent use.
+type MemSink struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+// NewMemSink creates an empty MemSink.
+func NewMemSink() *MemSink {
+	return &MemSink{files: make(map[string][]byte)}
+}
+
+// WriteFile keeps a copy of data under name, replacing what was written under it before.
+func (m *MemSink) WriteFile(name string, data []byte) error {
+	if !fs.ValidPath(name) {
+		return fmt.Errorf("error writing output: invalid name %q", name)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[name] = append([]byte(nil), data...)
+	return nil
+}
+
+// Files gets a copy of every file written so far by name.
+func (m *MemSink) Files() map[string][]byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	files := make(map[string][]byte, len(m.files))
+	for name, data := range m.files {
+		files[name] = data
+	}
+	return files
+}
+
+// manifestFile is the file added to the root of every archive, it lists the SHA-256 of every other
+// entry in the format of sha256sum.
+const manifestFile = "SHA256SUMS"
+
+// archiveTime is the modification time of every archive entry, it keeps archives of the same scan
+// byte-identical. It is the earliest time a zip can hold.
+var archiveTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// archiveEntries gets the names of the files sorted, after adding the manifestFile to them.
+func archiveEntries(files map[string][]byte) []string {
+	names := make([]string, 0, len(files)+1)
+	for name := range files {
+		if name != manifestFile {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	manifest := new(bytes.Buffer)
+	for _, name := range names {
+		fmt.Fprintf(manifest, "%x  %s\n", sha256.Sum256(files[name]), name)
+	}
+	files[manifestFile] = manifest.Bytes()
+	names = append(names, manifestFile)
+	sort.Strings(names)
+	return names
+}
+
+// ZipSink writes the output as a reproducible zip: the entries are kept until Close, then written
+// sorted by name with a fixed modification time along with the manifestFile.
+type ZipSink struct {
+	*MemSink
+	w io.Writer
+}
+
+// NewZipSink creates a ZipSink writing into w.
+func NewZipSink(w io.Writer) *ZipSink {
+	return &ZipSink{MemSink: NewMemSink(), w: w}
+}
+
+// Close writes the zip, it doesn't close the underlying writer.
+func (z *ZipSink) Close() error {
+	files := z.Files()
+	zw := zip.NewWriter(z.w)
+	for _, name := range archiveEntries(files) {
+		hdr := &zip.FileHeader{Name: name, Method: zip.Deflate, Modified: archiveTime}
+		hdr.SetMode(0644)
+		w, err := zw.CreateHeader(hdr)
+		if err != nil {
+			return fmt.Errorf("error adding zip entry: %w", err)
+		}
+		_, err = w.Write(files[name])
+		if err != nil {
+			return fmt.Errorf("error writing zip entry: %w", err)
+		}
+	}
+	return zw.Close()
+}
+
+// TarGzSink writes the output as a reproducible gzipped tar: the entries are kept until Close, then
+// written sorted by name with a fixed modification time along with the manifestFile.
+type TarGzSink struct {
+	*MemSink
+	w io.Writer
+}
+
+// NewTarGzSink creates a TarGzSink writing into w.
+func NewTarGzSink(w io.Writer) *TarGzSink {
+	return &TarGzSink{MemSink: NewMemSink(), w: w}
+}
+
+// Close writes the tar and the gzip stream, it doesn't close the underlying writer.
+func (t *TarGzSink) Close() error {
+	files := t.Files()
+	gw := gzip.NewWriter(t.w)
+	tw := tar.NewWriter(gw)
+	for _, name := range archiveEntries(files) {
+		hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(files[name])), ModTime: archiveTime, Typeflag: tar.TypeReg}
+		err := tw.WriteHeader(hdr)
+		if err != nil {
+			return fmt.Errorf("error adding tar entry: %w", err)
+		}
+		_, err = tw.Write(files[name])
+		if err != nil {
+			return fmt.Errorf("error writing tar entry: %w", err)
+		}
+	}
+	err := tw.Close()
+	if err != nil {
+		return fmt.Errorf("error closing tar: %w", err)
+	}
+	return gw.Close()
+}
+
+// archiveFile is a ZipSink or TarGzSink writing into a file.
+type archiveFile struct {
+	Sink
+	close func() error
+	file  *os.File
+}
+
+// createArchive creates the archive filename, a zip or a tar.gz depending on its extension.
+func createArchive(filename string) (*archiveFile, error) {
+	lower := strings.ToLower(filename)
+	if !strings.HasSuffix(lower, ".zip") && !strings.HasSuffix(lower, ".tar.gz") && !strings.HasSuffix(lower, ".tgz") {
+		return nil, fmt.Errorf("error creating archive: %s isn't a .zip, .tar.gz or .tgz file", filename)
+	}
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error creating archive: %w", err)
+	}
+	if strings.HasSuffix(lower, ".zip") {
+		zs := NewZipSink(file)
+		return &archiveFile{Sink: zs, close: zs.Close, file: file}, nil
+	}
+	ts := NewTarGzSink(file)
+	return &archiveFile{Sink: ts, close: ts.Close, file: file}, nil
+}
+
+// Close writes the archive and closes the file.
+func (a *archiveFile) Close() error {
+	err := a.close()
+	closeErr := a.file.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return fmt.Errorf("error closing archive: %w", closeErr)
+	}
+	return nil
+}
+
+// withArchive runs run with out set to the archive filename. The archive is written once run returns,
+// even if it failed, so partial reports are kept. Without a filename, or if out is already set, only
+// run is called.
+func withArchive(filename string, out *Sink, run func() error) error {
+	if filename == "" || *out != nil {
+		return run()
+	}
+	archive, err := createArchive(filename)
+	if err != nil {
+		return err
+	}
+	*out = archive
+	defer func() { *out = nil }()
+	err = run()
+	closeErr := archive.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+// writeReport writes a report into the file name of folder in out.
+func writeReport(out Sink, folder, name string, write func(w io.Writer) error) error {
+	buf := new(bytes.Buffer)
+	err := write(buf)
+	if err != nil {
+		return err
+	}
+	return out.WriteFile(path.Join(filepath.ToSlash(folder), name), buf.Bytes())
+}
+
+// output gets the sink the scanner writes into, the DstPath if no Output is set.
+func (s *Scanner) output() Sink {
+	if s.Output != nil {
+		return s.Output
+	}
+	return DirSink(s.DstPath)
+}
+
+// createReportFile writes a report into the file name of the LicFolder. Reports written into the
+// DstPath need a LicFolder, without one nothing was scanned.
+func (s *Scanner) createReportFile(name string, write func(w io.Writer) error) error {
+	if s.Output == nil && s.LicFolder == "" {
+		return fmt.Errorf("error writing %s: no LicFolder, nothing was scanned", name)
+	}
+	return writeReport(s.output(), s.LicFolder, name, write)
+}
+
+// output gets the sink the launch writes into, the Dst if no Output is set.
+func (l *Launch) output() Sink {
+	if l.Output != nil {
+		return l.Output
+	}
+	return DirSink(l.Dst)
+}
+
+// createReportFile writes a report into the file name of the LicFolder. Reports written into the Dst
+// need a LicFolder, without one the repo wasn't scanned.
+func (l *Launch) createReportFile(name string, write func(w io.Writer) error) error {
+	if l.Output == nil && l.Scanner.LicFolder == "" {
+		return fmt.Errorf("error writing %s: no LicFolder, the repo wasn't scanned", name)
+	}
+	return writeReport(l.output(), l.Scanner.LicFolder, name, write)
+}
@@ -0,0 +1,207 @@
+package lic
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestScanFS(t *testing.T) {
+	gopath := testGopath(t)
+	dst := t.TempDir()
+	scan, err := initScanner(gopath, filepath.Join(gopath, "pkg", "mod"), dst, "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan.Licenses = licenses{{Name: "MIT License", SPDX: "MIT", Lines: []string{DefinitionFormat("Permission is hereby granted")}}}
+	scan.ModFS = fstest.MapFS{
+		"example.com/mit@v1.0.0/LICENSE":   {Data: []byte("Permission is hereby granted")},
+		"example.com/mit@v1.0.0/go.mod":    {Data: []byte("module example.com/mit")},
+		"example.com/header@v1.0.0/a.go":   {Data: []byte("// SPDX-License-Identifier: MPL-2.0\npackage a")},
+		"example.com/header@v1.0.0/go.mod": {Data: []byte("module example.com/header")},
+	}
+	out := NewMemSink()
+	scan.Output = out
+	scan.LicFolder = "fs_Licenses"
+	scan.ProjectSum = "example.com/mit v1.0.0/go.mod h1:x=\nexample.com/header v1.0.0/go.mod h1:x=\nexample.com/missing v1.0.0/go.mod h1:x=\n"
+	err = scan.ScanPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scan.LicenseType["MIT License"]) != 1 || len(scan.LicenseType["MPL-2.0"]) != 1 || len(scan.Modules) != 2 {
+		t.Fatalf("Unexpected licenses: %v", scan.LicenseType)
+	}
+	files := out.Files()
+	for _, name := range []string{"fs_Licenses/" + licTypesFile, "fs_Licenses/" + modulesFile, "fs_Licenses/Licenses/" + licenseHash([]byte("Permission is hereby granted"))} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("Expected %s in the sink: %v", name, files)
+		}
+	}
+	if entries, _ := os.ReadDir(dst); len(entries) != 0 {
+		t.Errorf("Nothing should be written to the DstPath: %v", entries)
+	}
+}
+
+// cancelFS cancels a context once the file name is opened.
+type cancelFS struct {
+	fs.FS
+	name   string
+	cancel context.CancelFunc
+}
+
+func (c cancelFS) Open(name string) (fs.File, error) {
+	if name == c.name {
+		c.cancel()
+	}
+	return c.FS.Open(name)
+}
+
+func TestScanFSCancel(t *testing.T) {
+	gopath := testGopath(t)
+	scan, err := initScanner(gopath, filepath.Join(gopath, "pkg", "mod"), t.TempDir(), "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan.Licenses = licenses{{Name: "MIT License", SPDX: "MIT", Lines: []string{DefinitionFormat("Permission is hereby granted")}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scan.ModFS = cancelFS{FS: fstest.MapFS{
+		"example.com/done@v1.0.0/LICENSE":  {Data: []byte("Permission is hereby granted")},
+		"example.com/half@v1.0.0/LICENSE":  {Data: []byte("Permission is hereby granted")},
+		"example.com/half@v1.0.0/go.mod":   {Data: []byte("module example.com/half")},
+		"example.com/half@v1.0.0/x/NOTICE": {Data: []byte("Copyright 2020 Half")},
+	}, name: "example.com/half@v1.0.0/LICENSE", cancel: cancel}
+	scan.Output = NewMemSink()
+	scan.LicFolder = "cancel_Licenses"
+	scan.ProjectSum = "example.com/done v1.0.0/go.mod h1:x=\nexample.com/half v1.0.0/go.mod h1:x=\n"
+	err = scan.ScanPathContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the scan to be canceled: %v", err)
+	}
+	if _, ok := scan.Modules["example.com/done@v1.0.0"]; !ok || len(scan.Modules) != 1 {
+		t.Errorf("Expected only the complete module: %v", scan.Modules)
+	}
+	if infos := scan.LicenseType["MIT License"]; len(infos) != 1 || !strings.Contains(filepath.ToSlash(infos[0].Filename), "example.com/done@v1.0.0") {
+		t.Errorf("The licenses of the interrupted module should be removed: %v", scan.LicenseType)
+	}
+}
+
+func TestArchiveSinks(t *testing.T) {
+	files := map[string]string{"a_Licenses/licensetypes.json": "{}", "a_Licenses/Licenses/LICENSE": "text"}
+	write := func(names ...string) ([]byte, []byte) {
+		zipBuf, tarBuf := new(bytes.Buffer), new(bytes.Buffer)
+		zs, ts := NewZipSink(zipBuf), NewTarGzSink(tarBuf)
+		for _, name := range names {
+			for _, sink := range []Sink{zs, ts} {
+				if err := sink.WriteFile(name, []byte(files[name])); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		if err := zs.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := ts.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return zipBuf.Bytes(), tarBuf.Bytes()
+	}
+	zipBytes, tarBytes := write("a_Licenses/licensetypes.json", "a_Licenses/Licenses/LICENSE")
+	zipAgain, tarAgain := write("a_Licenses/Licenses/LICENSE", "a_Licenses/licensetypes.json")
+	if !bytes.Equal(zipBytes, zipAgain) || !bytes.Equal(tarBytes, tarAgain) {
+		t.Error("Archives of the same files should be byte-identical")
+	}
+	files[manifestFile] = "982d9e3eb996f559e633f4d194def3761d909f5a3b647d1a851fead67c32c9d1  a_Licenses/Licenses/LICENSE\n" +
+		"44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a  a_Licenses/licensetypes.json\n"
+
+	zr, err := zip.NewReader(bytes.NewReader(zipBytes), int64(len(zipBytes)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range files {
+		bs, err := fs.ReadFile(zr, name)
+		if err != nil || string(bs) != data {
+			t.Errorf("Unexpected zip entry %s: %q %v", name, bs, err)
+		}
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(tarBytes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gr)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		bs, _ := io.ReadAll(tr)
+		if files[hdr.Name] != string(bs) || !hdr.ModTime.Equal(archiveTime) {
+			t.Errorf("Unexpected tar entry %s %v: %q", hdr.Name, hdr.ModTime, bs)
+		}
+		names = append(names, hdr.Name)
+	}
+	if !sort.StringsAreSorted(names) || len(names) != len(files) {
+		t.Errorf("Expected the sorted entries of every file: %v", names)
+	}
+
+	if err := NewMemSink().WriteFile("../escape", nil); err == nil {
+		t.Error("Names outside of the sink should fail")
+	}
+	if _, err := createArchive(filepath.Join(t.TempDir(), "report.rar")); err == nil {
+		t.Error("Unknown archive extensions should fail")
+	}
+}
+
+func TestDirOutputPrune(t *testing.T) {
+	dst := t.TempDir()
+	own := filepath.Join(dst, "notes.txt")
+	stale := filepath.Join(dst, "repo_Licenses", "Licenses", "stale")
+	for _, name := range []string{own, stale} {
+		err := os.MkdirAll(filepath.Dir(name), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(name, []byte("old"), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	dir := newDirOutput(dst)
+	err := dir.WriteFile("repo_Licenses/licensetypes.json", []byte("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, folder := range []string{"", ".", "..", "/", "other_Licenses"} {
+		if err := dir.prune(folder); err == nil {
+			t.Errorf("Expected pruning %q to be refused", folder)
+		}
+	}
+	if _, err := os.Stat(own); err != nil {
+		t.Fatalf("A refused prune shouldn't remove files of the dst: %v", err)
+	}
+	err = dir.prune("repo_Licenses")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(stale); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Expected the stale copy to be pruned: %v", err)
+	}
+	if _, err := os.Stat(own); err != nil {
+		t.Errorf("Files outside the LicFolder shouldn't be pruned: %v", err)
+	}
+}
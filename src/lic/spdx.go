@@ -0,0 +1,146 @@
+package lic
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// spdxTag is the tag source files use to declare their license.
+const spdxTag = "SPDX-License-Identifier:"
+
+// spdxHeaderSize is how much of a source file is read when looking for SPDX headers, the
+// tag is expected to be in the header comment of the file.
+const spdxHeaderSize = 4096
+
+// expressionsFile is the json file in the LicFolder that holds the license expression of every module.
+const expressionsFile = "licenseexpressions.json"
+
+// spdxExtensions are the source file extensions that are scanned for SPDX headers.
+var spdxExtensions = map[string]struct{}{
+	".go": {}, ".c": {}, ".h": {}, ".cc": {}, ".cpp": {}, ".cxx": {}, ".hpp": {}, ".s": {},
+	".js": {}, ".mjs": {}, ".ts": {}, ".py": {}, ".rb": {}, ".rs": {}, ".java": {}, ".cs": {},
+	".sh": {}, ".swift": {}, ".php": {}, ".proto": {}, ".m": {},
+}
+
+// spdxExpression matches the characters allowed in an SPDX license expression.
+var spdxExpression = regexp.MustCompile(`^[A-Za-z0-9.+\-:() ]+$`)
+
+// licenseRefChars matches the characters a LicenseRef can't hold.
+var licenseRefChars = regexp.MustCompile(`[^A-Za-z0-9.\-]+`)
+
+// moduleExpression is the struct written to the expressionsFile for a single module.
+type moduleExpression struct {
+	Expression  string
+	SPDXHeaders []string `json:",omitempty"`
+}
+
+// isSourceFile is true if the file should be scanned for SPDX headers. Other files of the ExcludedEXT,
+// like .json, aren't source files.
+func isSourceFile(name string) bool {
+	_, ok := spdxExtensions[strings.ToLower(filepath.Ext(name))]
+	return ok
+}
+
+// readSPDXHeader records the SPDX identifiers found in the first spdxHeaderSize bytes of r.
+func (s *Scanner) readSPDXHeader(r io.Reader) error {
+	if s.CurrentModule == nil {
+		return nil
+	}
+	bs, err := io.ReadAll(io.LimitReader(r, spdxHeaderSize))
+	if err != nil {
+		return fmt.Errorf("unable to read file: %w", err)
+	}
+	for _, id := range parseSPDXHeader(bs) {
+		s.CurrentModule.SPDXHeaders = appendUnique(s.CurrentModule.SPDXHeaders, id)
+	}
+	return nil
+}
+
+// parseSPDXHeader gets the license expressions of every SPDX-License-Identifier tag in bs.
+func parseSPDXHeader(bs []byte) []string {
+	var ids []string
+	if !bytes.Contains(bs, []byte(spdxTag)) {
+		return ids
+	}
+	sc := bufio.NewScanner(bytes.NewReader(bs))
+	for sc.Scan() {
+		line := sc.Text()
+		i := strings.Index(line, spdxTag)
+		if i < 0 {
+			continue
+		}
+		expr := line[i+len(spdxTag):]
+		for _, end := range []string{"*/", "-->", "#}", "--}"} {
+			if j := strings.Index(expr, end); j >= 0 {
+				expr = expr[:j]
+			}
+		}
+		expr = strings.TrimSpace(expr)
+		if expr == "" || !spdxExpression.MatchString(expr) {
+			continue
+		}
+		ids = appendUnique(ids, expr)
+	}
+	return ids
+}
+
+// moduleExpression combines the SPDX identifiers of the licenses found in a module and its SPDX
+// headers into a single license expression. Unknown License and No License results are left out.
+func (s *Scanner) moduleExpression(mod *scannedModule) string {
+	var alternatives, required []string
+	headers := make(map[string]struct{})
+	for _, id := range mod.SPDXHeaders {
+		headers[id] = struct{}{}
+	}
+	alts := mod.alternatives()
+	for _, l := range mod.Licenses {
+		_, fromHeader := headers[l.License]
+		if l.License == unknownLicense || l.License == noLicense || l.License == noticeFile || fromHeader {
+			continue
+		}
+		id := s.spdxID(l.License)
+		if _, ok := alts[l.Info.Filename]; ok {
+			alternatives = appendUnique(alternatives, id)
+			continue
+		}
+		required = appendUnique(required, id)
+	}
+	for _, id := range mod.SPDXHeaders {
+		required = appendUnique(required, id)
+	}
+	if len(alternatives)+len(required) == 0 {
+		return ""
+	}
+	return combineLicenses(alternatives, required)
+}
+
+// spdxID gets the SPDX identifier of a defined license name or identifier. Licenses without one become
+// a LicenseRef.
+func (l licenses) spdxID(name string) string {
+	def, ok := l.find(name)
+	if ok && def.SPDX != "" {
+		return def.SPDX
+	}
+	return "LicenseRef-" + strings.Trim(licenseRefChars.ReplaceAllString(name, "-"), "-")
+}
+
+// createExpressionsFile writes the license expression of every module into the expressionsFile.
+func createExpressionsFile(scanner Scanner) error {
+	return scanner.createReportFile(expressionsFile, func(w io.Writer) error {
+		return writeExpressions(w, scanner)
+	})
+}
+
+// writeExpressions writes the license expression of every module as json.
+func writeExpressions(w io.Writer, scanner Scanner) error {
+	exprs := make(map[string]moduleExpression)
+	for name, mod := range scanner.Modules {
+		exprs[name] = moduleExpression{Expression: mod.Expression, SPDXHeaders: mod.SPDXHeaders}
+	}
+	return writeJson(w, exprs, "license expressions")
+}
@@ -0,0 +1,60 @@
+package lic
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseSPDXHeader(t *testing.T) {
+	src := "// SPDX-License-Identifier: MIT\n/* SPDX-License-Identifier: Apache-2.0 OR MIT */\n<!-- SPDX-License-Identifier: BSD-3-Clause -->\nconst tag = \"SPDX-License-Identifier: \" + id\n// SPDX-License-Identifier: MIT\n"
+	ids := parseSPDXHeader([]byte(src))
+	if !reflect.DeepEqual(ids, []string{"MIT", "Apache-2.0 OR MIT", "BSD-3-Clause"}) {
+		t.Fatalf("Unexpected identifiers: %q", ids)
+	}
+}
+
+func TestSPDXHeaderModules(t *testing.T) {
+	proxy := newTestProxy(t)
+	proxy.add("example.com/header", "v1.0.0", map[string]string{
+		"a.go": "// SPDX-License-Identifier: MPL-2.0\npackage a",
+		"b.c":  "/* SPDX-License-Identifier: MIT */",
+	})
+	proxy.add("example.com/licensecheck", "v1.0.0", map[string]string{
+		"LICENSE":   "Permission is hereby granted",
+		"check.go":  "// SPDX-License-Identifier: BSD-3-Clause\npackage licensecheck",
+		"data.json": `{"header": "SPDX-License-Identifier: GPL-3.0-only"}`,
+	})
+	proxy.add("example.com/mixed", "v1.0.0", map[string]string{
+		"LICENSE": "Permission is hereby granted",
+		"x.go":    "// SPDX-License-Identifier: Apache-2.0 OR MIT\npackage x",
+	})
+	dst := t.TempDir()
+	scan := newProxyScanner(t, proxy, dst, "spdx")
+	scan.Licenses = licenses{{Name: "MIT License", SPDX: "MIT", Lines: []string{DefinitionFormat("Permission is hereby granted")}}}
+	scan.ExcludedEXT = excludedEXT{".go": {}, ".json": {}}
+	err := scan.ScanPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := scan.LicenseType[noLicense]; ok {
+		t.Fatalf("Header only module should not be No License: %v", scan.LicenseType)
+	}
+	if len(scan.LicenseType["MPL-2.0"]) != 1 || len(scan.LicenseType["MIT"]) != 1 {
+		t.Fatalf("Expected header licenses: %v", scan.LicenseType)
+	}
+	if expr := scan.Modules["example.com/header@v1.0.0"].Expression; expr != "MIT AND MPL-2.0" {
+		t.Fatalf("Unexpected expression: %s", expr)
+	}
+	if expr := scan.Modules["example.com/mixed@v1.0.0"].Expression; expr != "(Apache-2.0 OR MIT) AND MIT" {
+		t.Fatalf("Unexpected expression: %s", expr)
+	}
+	// The source files of a module with license in its path are still scanned, the json files aren't.
+	if expr := scan.Modules["example.com/licensecheck@v1.0.0"].Expression; expr != "BSD-3-Clause AND MIT" {
+		t.Fatalf("Unexpected expression: %s", expr)
+	}
+	if _, err = os.Stat(filepath.Join(dst, scan.LicFolder, expressionsFile)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,178 @@
+// Command gen generates licenses_gen.go from a release of the SPDX license list data
+// (https://github.com/spdx/license-list-data). It reads json/licenses.json and
+// template/<id>.template.txt either from a local checkout (-src) or from the release archive of -version.
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// releaseURL is the archive of a release of the SPDX license list data.
+const releaseURL = "https://github.com/spdx/license-list-data/archive/refs/tags/v%s.tar.gz"
+
+// licenseList is json/licenses.json of the SPDX license list data.
+type licenseList struct {
+	LicenseListVersion string `json:"licenseListVersion"`
+	Licenses           []struct {
+		LicenseID             string `json:"licenseId"`
+		Name                  string `json:"name"`
+		IsOsiApproved         bool   `json:"isOsiApproved"`
+		IsDeprecatedLicenseID bool   `json:"isDeprecatedLicenseId"`
+	} `json:"licenses"`
+}
+
+func main() {
+	src := flag.String("src", "", "The src flag is a local checkout of the SPDX license-list-data, if empty the release of -version is downloaded")
+	version := flag.String("version", "", "The version flag is the SPDX license list release to download, with -src it is checked against the version of the checkout")
+	ids := flag.String("ids", "", "The ids flag is a file listing the SPDX identifiers to embed, one per line, if empty every license that isn't deprecated is embedded")
+	out := flag.String("out", "licenses_gen.go", "The out flag is the file to write")
+	flag.Parse()
+
+	read, err := source(*src, *version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	bs, err := read("json/licenses.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var list licenseList
+	err = json.Unmarshal(bs, &list)
+	if err != nil {
+		log.Fatalf("error unmarshaling licenses.json: %v", err)
+	}
+	if *version != "" && list.LicenseListVersion != *version {
+		log.Fatalf("error: the license list is version %s, not %s", list.LicenseListVersion, *version)
+	}
+	wanted, err := readIDs(*ids)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen from the SPDX license list data; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package spdxlicenses\n\n")
+	fmt.Fprintf(&buf, "// ListVersion is the version of the SPDX license list the licenses were generated from.\n")
+	fmt.Fprintf(&buf, "const ListVersion = %q\n\n", list.LicenseListVersion)
+	fmt.Fprintf(&buf, "var licenses = []License{\n")
+	found := make(map[string]bool)
+	for _, l := range list.Licenses {
+		if wanted != nil && !wanted[l.LicenseID] || wanted == nil && l.IsDeprecatedLicenseID {
+			continue
+		}
+		tmpl, err := read("template/" + l.LicenseID + ".template.txt")
+		if err != nil {
+			log.Fatal(err)
+		}
+		found[l.LicenseID] = true
+		fmt.Fprintf(&buf, "\t{ID: %q, Name: %q, OSIApproved: %t, Template: %s},\n", l.LicenseID, l.Name, l.IsOsiApproved, strconv.Quote(string(tmpl)))
+	}
+	fmt.Fprintf(&buf, "}\n")
+	for id := range wanted {
+		if !found[id] {
+			log.Fatalf("license %s isn't in the SPDX license list %s", id, list.LicenseListVersion)
+		}
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("error formatting generated code: %v", err)
+	}
+	err = os.WriteFile(*out, code, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Generated %d licenses from SPDX license list %s", len(found), list.LicenseListVersion)
+}
+
+// source creates a function that reads a file of the license list data.
+func source(src, version string) (func(string) ([]byte, error), error) {
+	if src != "" {
+		return func(name string) ([]byte, error) {
+			return os.ReadFile(filepath.Join(src, filepath.FromSlash(name)))
+		}, nil
+	}
+	if version == "" {
+		return nil, fmt.Errorf("error: -src or -version is required")
+	}
+	files, err := download(fmt.Sprintf(releaseURL, version))
+	if err != nil {
+		return nil, err
+	}
+	return func(name string) ([]byte, error) {
+		bs, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("error reading %s: not in the release archive", name)
+		}
+		return bs, nil
+	}, nil
+}
+
+// download gets the json and template files of a release archive, the top directory of the archive is removed from their names.
+func download(url string) (map[string][]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error downloading %s: %s", url, resp.Status)
+	}
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", url, err)
+	}
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", url, err)
+		}
+		_, name, ok := strings.Cut(hdr.Name, "/")
+		if !ok || hdr.Typeflag != tar.TypeReg || !(name == "json/licenses.json" || strings.HasPrefix(name, "template/")) {
+			continue
+		}
+		bs, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", hdr.Name, err)
+		}
+		files[name] = bs
+	}
+}
+
+// readIDs reads the ids file, blank lines and lines starting with # are skipped.
+func readIDs(path string) (map[string]bool, error) {
+	if path == "" {
+		return nil, nil
+	}
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading ids: %w", err)
+	}
+	ids := make(map[string]bool)
+	for _, line := range strings.Split(string(bs), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ids[line] = true
+	}
+	return ids, nil
+}
@@ -0,0 +1,29 @@
+// Package spdxlicenses holds the licenses of the SPDX license list and their matching templates.
+// The list is generated from a release of https://github.com/spdx/license-list-data, only the
+// licenses listed in ids.txt are embedded to keep the binary small.
+package spdxlicenses
+
+//go:generate go run ./gen -version 3.24.0 -ids ids.txt -out licenses_gen.go
+
+// License is a single license of the SPDX license list.
+type License struct {
+	ID          string
+	Name        string
+	OSIApproved bool
+	Template    string // SPDX license template, see https://spdx.github.io/spdx-spec/v2.3/license-matching-guidelines-and-templates/
+}
+
+// List gets every embedded license.
+func List() []License {
+	return append([]License{}, licenses...)
+}
+
+// Lookup gets an embedded license by its SPDX identifier.
+func Lookup(id string) (License, bool) {
+	for _, l := range licenses {
+		if l.ID == id {
+			return l, true
+		}
+	}
+	return License{}, false
+}
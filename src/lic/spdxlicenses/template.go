@@ -0,0 +1,353 @@
+package spdxlicenses
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// maxVarWords caps the number of words a replaceable segment can match.
+const maxVarWords = 200
+
+// defaultVarWords is the number of words a replaceable segment can match if its match regex has no bound.
+const defaultVarWords = 30
+
+// maxSteps bounds the work of a single match, templates that need more are treated as not matching.
+const maxSteps = 1_000_000
+
+// templateTag finds the markup of a template: <<var;...>>, <<beginOptional>> and <<endOptional>>.
+var templateTag = regexp.MustCompile(`(?s)<<(var;.*?|beginOptional.*?|endOptional)>>`)
+
+// varBound gets the upper bound of a var match regex such as .{0,5000}.
+var varBound = regexp.MustCompile(`\{\d*,(\d+)\}`)
+
+// equivalents are the words that the SPDX matching guidelines treat as the same.
+var equivalents = map[string]string{
+	"licence":         "license",
+	"licences":        "licenses",
+	"sublicence":      "sublicense",
+	"https":           "http",
+	"acknowledgement": "acknowledgment",
+	"analogue":        "analog",
+	"offence":         "offense",
+	"whilst":          "while",
+	"wilful":          "willful",
+	"favour":          "favor",
+	"behaviour":       "behavior",
+	"organisation":    "organization",
+	"recognised":      "recognized",
+	"authorised":      "authorized",
+	"utilise":         "utilize",
+	"centre":          "center",
+	"fulfil":          "fulfill",
+	"labelled":        "labeled",
+	"modelling":       "modeling",
+	"programme":       "program",
+	"artefact":        "artifact",
+	"catalogue":       "catalog",
+}
+
+// node is a part of a compiled template.
+type node struct {
+	word     string // A word that has to be present.
+	maxWords int    // The number of words a replaceable segment can match, used if word and optional are empty.
+	optional []node // An optional segment.
+}
+
+// Matcher matches normalized texts against a license template.
+type Matcher struct {
+	nodes    []node
+	required []string // Words outside of optional segments, used to reject texts quickly.
+	size     int      // Number of words of the template, used to prefer the most specific match.
+
+	once    sync.Once
+	bigrams map[[2]string]bool // Pairs of consecutive words of the template, used by Similarity.
+}
+
+// Words normalizes a text into the words used for matching. Case, punctuation, markup and
+// spelling variants are ignored as described by the SPDX matching guidelines.
+func Words(text string) []string {
+	words, _ := Spans(text)
+	return words
+}
+
+// Spans normalizes a text like Words and also gets the byte range of every word in the text.
+func Spans(text string) ([]string, [][2]int) {
+	var words []string
+	var spans [][2]int
+	start := -1
+	for i, r := range text + " " {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start < 0 {
+			continue
+		}
+		w := strings.ToLower(text[start:i])
+		if eq, ok := equivalents[w]; ok {
+			w = eq
+		}
+		words = append(words, w)
+		spans = append(spans, [2]int{start, i})
+		start = -1
+	}
+	return words, spans
+}
+
+// Compile parses a license template.
+func Compile(template string) (*Matcher, error) {
+	stack := [][]node{nil}
+	last := 0
+	for _, loc := range templateTag.FindAllStringSubmatchIndex(template, -1) {
+		top := len(stack) - 1
+		stack[top] = append(stack[top], textNodes(template[last:loc[0]])...)
+		last = loc[1]
+		tag := template[loc[2]:loc[3]]
+		switch {
+		case strings.HasPrefix(tag, "var;"):
+			stack[top] = append(stack[top], node{maxWords: varWords(tag)})
+		case strings.HasPrefix(tag, "beginOptional"):
+			stack = append(stack, nil)
+		default:
+			if top == 0 {
+				return nil, fmt.Errorf("error parsing template: endOptional without beginOptional at %d", loc[0])
+			}
+			stack[top-1] = append(stack[top-1], node{optional: stack[top]})
+			stack = stack[:top]
+		}
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("error parsing template: %d optional segments aren't closed", len(stack)-1)
+	}
+	nodes := append(stack[0], textNodes(template[last:])...)
+	// Texts are searched for the template, leading and trailing segments that can be empty don't
+	// change the result.
+	for len(nodes) > 0 && nodes[0].word == "" {
+		nodes = nodes[1:]
+	}
+	for len(nodes) > 0 && nodes[len(nodes)-1].word == "" {
+		nodes = nodes[:len(nodes)-1]
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("error parsing template: no required text")
+	}
+	m := &Matcher{nodes: nodes}
+	for _, n := range nodes {
+		if n.word != "" {
+			m.required = append(m.required, n.word)
+		}
+	}
+	m.size = countWords(nodes)
+	return m, nil
+}
+
+// textNodes creates a node for every word of a literal part of a template.
+func textNodes(text string) []node {
+	var nodes []node
+	for _, w := range Words(text) {
+		nodes = append(nodes, node{word: w})
+	}
+	return nodes
+}
+
+// varWords gets the number of words a <<var;...>> tag can match from its match regex.
+func varWords(tag string) int {
+	_, match, ok := strings.Cut(tag, `match="`)
+	if !ok {
+		return defaultVarWords
+	}
+	bound := varBound.FindStringSubmatch(match)
+	if bound == nil {
+		return defaultVarWords
+	}
+	chars, err := strconv.Atoi(bound[1])
+	if err != nil {
+		return defaultVarWords
+	}
+	// A word and its separator take at least two characters.
+	words := chars/2 + 1
+	if words > maxVarWords {
+		words = maxVarWords
+	}
+	return words
+}
+
+// countWords counts the words of the nodes including the words of optional segments.
+func countWords(nodes []node) int {
+	n := 0
+	for _, nd := range nodes {
+		if nd.word != "" {
+			n++
+		}
+		n += countWords(nd.optional)
+	}
+	return n
+}
+
+// Size gets the number of words of the template.
+func (m *Matcher) Size() int {
+	return m.size
+}
+
+// RequiredWords gets the words of the template that aren't in optional or replaceable segments.
+func (m *Matcher) RequiredWords() []string {
+	return append([]string{}, m.required...)
+}
+
+// LiteralWords gets the words of the template including the words of optional segments.
+func (m *Matcher) LiteralWords() []string {
+	return literalWords(m.nodes)
+}
+
+// Segments gets the runs of literal words of the template, a run ends at every replaceable segment and
+// at the start and end of every optional segment.
+func (m *Matcher) Segments() [][]string {
+	return segments(m.nodes, nil)
+}
+
+// segments appends the runs of literal words of the nodes to runs.
+func segments(nodes []node, runs [][]string) [][]string {
+	var run []string
+	for _, n := range nodes {
+		if n.word != "" {
+			run = append(run, n.word)
+			continue
+		}
+		if len(run) > 0 {
+			runs = append(runs, run)
+		}
+		run = nil
+		runs = segments(n.optional, runs)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+// Match checks if the normalized words contain the license. The set is the words as a set, it can be
+// shared between matchers to reject texts that lack required words without searching them.
+func (m *Matcher) Match(words []string, set map[string]bool) bool {
+	_, _, ok := m.Find(words, set)
+	return ok
+}
+
+// Find is Match that also gets the range of words that matched the license, end is exclusive.
+func (m *Matcher) Find(words []string, set map[string]bool) (start, end int, ok bool) {
+	for _, w := range m.required {
+		if !set[w] {
+			return 0, 0, false
+		}
+	}
+	steps := 0
+	for i, w := range words {
+		if w != m.nodes[0].word {
+			continue
+		}
+		found := false
+		matchNodes(m.nodes, words, i, &steps, func(pos int) bool {
+			end, found = pos, true
+			return true
+		})
+		if found {
+			return i, end, true
+		}
+		if steps > maxSteps {
+			break
+		}
+	}
+	return 0, 0, false
+}
+
+// Similarity gets the Dice coefficient of the pairs of consecutive words of the text and of the
+// template, optional segments included. It is 1 for texts that only differ in the replaceable segments
+// and close to 0 for unrelated texts.
+func (m *Matcher) Similarity(words []string) float64 {
+	m.once.Do(func() {
+		m.bigrams = bigrams(literalWords(m.nodes))
+	})
+	text := bigrams(words)
+	if len(text)+len(m.bigrams) == 0 {
+		return 0
+	}
+	shared := 0
+	for b := range text {
+		if m.bigrams[b] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(text)+len(m.bigrams))
+}
+
+// literalWords gets the words of the nodes in order, including the words of optional segments.
+func literalWords(nodes []node) []string {
+	var words []string
+	for _, n := range nodes {
+		if n.word != "" {
+			words = append(words, n.word)
+		}
+		words = append(words, literalWords(n.optional)...)
+	}
+	return words
+}
+
+// bigrams gets the set of pairs of consecutive words.
+func bigrams(words []string) map[[2]string]bool {
+	set := make(map[[2]string]bool, len(words))
+	for i := 1; i < len(words); i++ {
+		set[[2]string{words[i-1], words[i]}] = true
+	}
+	return set
+}
+
+// WordSet creates the set of words passed to Match.
+func WordSet(words []string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
+
+// matchNodes matches the nodes starting at words[pos] and calls next with the position after every
+// possible match until next returns true. It reports whether next returned true.
+func matchNodes(nodes []node, words []string, pos int, steps *int, next func(int) bool) bool {
+	*steps++
+	if *steps > maxSteps {
+		return false
+	}
+	if len(nodes) == 0 {
+		return next(pos)
+	}
+	n, rest := nodes[0], nodes[1:]
+	switch {
+	case n.word != "":
+		if pos < len(words) && words[pos] == n.word {
+			return matchNodes(rest, words, pos+1, steps, next)
+		}
+		return false
+	case n.optional != nil:
+		if matchNodes(n.optional, words, pos, steps, func(end int) bool {
+			return matchNodes(rest, words, end, steps, next)
+		}) {
+			return true
+		}
+		return matchNodes(rest, words, pos, steps, next)
+	default:
+		for i := 0; i <= n.maxWords && pos+i <= len(words); i++ {
+			if matchNodes(rest, words, pos+i, steps, next) {
+				return true
+			}
+			if *steps > maxSteps {
+				return false
+			}
+		}
+		return false
+	}
+}
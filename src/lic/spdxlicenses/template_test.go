@@ -0,0 +1,56 @@
+package spdxlicenses
+
+import (
+	"testing"
+)
+
+func TestTemplatesCompile(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, l := range List() {
+		if seen[l.ID] {
+			t.Errorf("Duplicate license: %s", l.ID)
+		}
+		seen[l.ID] = true
+		_, err := Compile(l.Template)
+		if err != nil {
+			t.Errorf("Unable to compile %s: %v", l.ID, err)
+		}
+	}
+	if _, ok := Lookup("Zlib"); !ok {
+		t.Fatal("Zlib should be embedded")
+	}
+}
+
+func TestTemplateMatch(t *testing.T) {
+	m, err := Compile(`<<beginOptional>>Example License<<endOptional>>
+<<var;name="copyright";original="Copyright (c) <year> <owner>";match=".{0,20}">>
+Permission is granted to <<var;name="who";original="anyone";match=".+">> to use this software<<beginOptional>>, for any purpose<<endOptional>>.
+The licence must be kept.`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		text  string
+		match bool
+	}{
+		{"Permission is granted to anyone to use this software. The licence must be kept.", true},
+		{"// Copyright 2020 Someone\n// PERMISSION is granted to every single person to use this software, for any purpose.\n// The license must be kept.", true},
+		{"Example License\n\nPermission is granted to anyone to use this software, for any purpose. The license must be kept. Extra text.", true},
+		{"Permission is granted to anyone to use this software. The license must be removed.", false},
+		{"Permission is granted to anyone to use this software, for some purposes. The license must be kept.", false},
+	}
+	for _, test := range tests {
+		words := Words(test.text)
+		if got := m.Match(words, WordSet(words)); got != test.match {
+			t.Errorf("Match(%q) = %v, want %v", test.text, got, test.match)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, tmpl := range []string{"<<beginOptional>>text", "text<<endOptional>>", `<<var;name="x";original="";match=".+">>`} {
+		if _, err := Compile(tmpl); err == nil {
+			t.Errorf("Compile(%q) should fail", tmpl)
+		}
+	}
+}